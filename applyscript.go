@@ -0,0 +1,57 @@
+/*
+Copyright 2015 Kelsey Hightower All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// newApplyScript returns a shell script applying manifests, which are
+// relative to the directory of the script, with kubectl apply. The manifests
+// are applied in the order of their file names, which start with the apply
+// order of their kind. The arguments of the script, such as --context, are
+// passed on to kubectl, which also picks up KUBECONFIG from the environment.
+func newApplyScript(manifests []string) []byte {
+	sorted := make([]string, len(manifests))
+	copy(sorted, manifests)
+	sort.Stable(manifestsByFileName(sorted))
+
+	var buf bytes.Buffer
+	buf.WriteString("#!/bin/sh\n")
+	buf.WriteString("# Generated by compose2kube. Applies the manifests in dependency order.\n")
+	buf.WriteString("# Usage: ./apply.sh [kubectl flags, e.g. --context my-cluster]\n")
+	buf.WriteString("set -e\n")
+	buf.WriteString("cd \"$(dirname \"$0\")\"\n")
+	for _, manifest := range sorted {
+		fmt.Fprintf(&buf, "kubectl apply \"$@\" -f %s\n", shellQuote(manifest))
+	}
+	return buf.Bytes()
+}
+
+// shellQuote quotes s for use as a single shell word.
+func shellQuote(s string) string {
+	return "'" + strings.Replace(s, "'", `'\''`, -1) + "'"
+}
+
+type manifestsByFileName []string
+
+func (m manifestsByFileName) Len() int      { return len(m) }
+func (m manifestsByFileName) Swap(i, j int) { m[i], m[j] = m[j], m[i] }
+func (m manifestsByFileName) Less(i, j int) bool {
+	return filepath.Base(m[i]) < filepath.Base(m[j])
+}
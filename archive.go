@@ -0,0 +1,84 @@
+/*
+Copyright 2015 Kelsey Hightower All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// archiveWriter streams the output files into a tar archive, compressed with
+// gzip when the archive name ends in .gz or .tgz.
+type archiveWriter struct {
+	file    *os.File
+	gzip    *gzip.Writer
+	tar     *tar.Writer
+	modTime time.Time
+}
+
+// createArchive creates the archive at path.
+func createArchive(path string) (*archiveWriter, error) {
+	f, err := os.Create(path)
+	if err != nil {
+		return nil, err
+	}
+	a := &archiveWriter{file: f, modTime: time.Now()}
+	var w io.Writer = f
+	if strings.HasSuffix(path, ".gz") || strings.HasSuffix(path, ".tgz") {
+		a.gzip = gzip.NewWriter(f)
+		w = a.gzip
+	}
+	a.tar = tar.NewWriter(w)
+	return a, nil
+}
+
+// add adds a file holding data to the archive. The file is named after path
+// relative to the output directory, which it must be inside of.
+func (a *archiveWriter) add(path string, data []byte, perm os.FileMode) error {
+	name, err := filepath.Rel(outputDir, path)
+	if err != nil || name == ".." || strings.HasPrefix(name, ".."+string(filepath.Separator)) {
+		return fmt.Errorf("%s is outside the output directory %s", path, outputDir)
+	}
+	header := &tar.Header{
+		Name:    filepath.ToSlash(name),
+		Mode:    int64(perm),
+		Size:    int64(len(data)),
+		ModTime: a.modTime,
+	}
+	if err := a.tar.WriteHeader(header); err != nil {
+		return err
+	}
+	_, err = a.tar.Write(data)
+	return err
+}
+
+// Close finishes the archive and closes its file.
+func (a *archiveWriter) Close() error {
+	err := a.tar.Close()
+	if a.gzip != nil {
+		if gzErr := a.gzip.Close(); err == nil {
+			err = gzErr
+		}
+	}
+	if fileErr := a.file.Close(); err == nil {
+		err = fileErr
+	}
+	return err
+}
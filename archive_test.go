@@ -0,0 +1,113 @@
+/*
+Copyright 2015 Kelsey Hightower All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+)
+
+// readArchive returns the files of the archive at path by name.
+func readArchive(t *testing.T, path string) map[string]string {
+	t.Helper()
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	var r io.Reader = f
+	if strings.HasSuffix(path, ".gz") {
+		gz, err := gzip.NewReader(f)
+		if err != nil {
+			t.Fatal(err)
+		}
+		r = gz
+	}
+
+	files := make(map[string]string)
+	tr := tar.NewReader(r)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return files
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, err := ioutil.ReadAll(tr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if header.Mode != 0644 {
+			t.Errorf("%s has mode %04o, want 0644", header.Name, header.Mode)
+		}
+		files[header.Name] = string(data)
+	}
+}
+
+func TestOutputArchive(t *testing.T) {
+	for _, name := range []string{"manifests.tar", "manifests.tar.gz"} {
+		dir := withOutput(t, formatYAML)
+		path := filepath.Join(t.TempDir(), name)
+		var err error
+		if archive, err = createArchive(path); err != nil {
+			t.Fatal(err)
+		}
+		writeObjects(convertCompose(t, twoServices).Objects, nil)
+		err = archive.Close()
+		archive = nil
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		files := readArchive(t, path)
+		var names []string
+		for name, data := range files {
+			names = append(names, name)
+			if !strings.Contains(data, "kind: ") {
+				t.Errorf("%s holds %q, want a manifest", name, data)
+			}
+		}
+		sort.Strings(names)
+		want := []string{"20-web-svc.yaml", "30-database-rc.yaml", "30-web-deployment.yaml"}
+		if !reflect.DeepEqual(names, want) {
+			t.Errorf("%s: got entries %q, want %q", name, names, want)
+		}
+
+		// Nothing is written to the output directory.
+		if got := listFiles(t, dir); len(got) > 0 {
+			t.Errorf("%s: got files %q in the output directory, want none", name, got)
+		}
+	}
+}
+
+func TestArchiveOutsideOutputDir(t *testing.T) {
+	withOutput(t, formatYAML)
+	a, err := createArchive(filepath.Join(t.TempDir(), "manifests.tar"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer a.Close()
+	if err := a.add(filepath.Join(outputDir, "..", "escape.yaml"), nil, 0644); err == nil {
+		t.Error("adding a file outside the output directory succeeded, want an error")
+	}
+}
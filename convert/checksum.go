@@ -0,0 +1,184 @@
+/*
+Copyright 2015 Kelsey Hightower All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package convert
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"sort"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/apis/apps"
+	"k8s.io/kubernetes/pkg/apis/extensions"
+	"k8s.io/kubernetes/pkg/runtime"
+)
+
+// configChecksumAnnotation holds the hash of the data of the config maps and
+// secrets used by a pod template, so that changing them changes the
+// template and rolls the pods out on the next apply.
+const configChecksumAnnotation = "checksum/config"
+
+// configChecksumMutator annotates the pod templates of the controllers with
+// the checksum of the generated config maps and secrets they use, including
+// the controllers wrapped in a custom resource. Bare pods, which cannot be
+// rolled out, are annotated themselves to record the config they were created
+// with. Objects that are not generated, such as external secrets, are left
+// out. Jobs are left alone, as their pod template cannot be changed.
+type configChecksumMutator struct {
+	configMaps map[string]map[string]string
+	secrets    map[string]map[string][]byte
+}
+
+// newConfigChecksumMutator indexes the config maps and secrets among objs.
+func newConfigChecksumMutator(objs []Object) configChecksumMutator {
+	m := configChecksumMutator{
+		configMaps: make(map[string]map[string]string),
+		secrets:    make(map[string]map[string][]byte),
+	}
+	for _, obj := range objs {
+		switch o := obj.Object.(type) {
+		case *api.ConfigMap:
+			m.configMaps[o.Name] = o.Data
+		case *api.Secret:
+			m.secrets[o.Name] = o.Data
+		}
+	}
+	return m
+}
+
+// Mutate sets the checksum annotation on the pod template of obj, or on a
+// bare pod, when it uses any of the generated config maps or secrets.
+func (m configChecksumMutator) Mutate(obj runtime.Object) error {
+	switch o := obj.(type) {
+	case *api.ReplicationController:
+		if o.Spec.Template != nil {
+			m.annotate(&o.Spec.Template.ObjectMeta, &o.Spec.Template.Spec)
+		}
+	case *extensions.Deployment:
+		m.annotate(&o.Spec.Template.ObjectMeta, &o.Spec.Template.Spec)
+	case *apps.StatefulSet:
+		m.annotate(&o.Spec.Template.ObjectMeta, &o.Spec.Template.Spec)
+	case *api.Pod:
+		m.annotate(&o.ObjectMeta, &o.Spec)
+	case *podTemplateFragment:
+		m.annotate(&o.ObjectMeta, &o.Spec)
+	case *customResource:
+		// The spec of the wrapped controller is held by value.
+		switch spec := o.Spec.Template.(type) {
+		case api.ReplicationControllerSpec:
+			if spec.Template != nil {
+				m.annotate(&spec.Template.ObjectMeta, &spec.Template.Spec)
+			}
+		case extensions.DeploymentSpec:
+			m.annotate(&spec.Template.ObjectMeta, &spec.Template.Spec)
+			o.Spec.Template = spec
+		case apps.StatefulSetSpec:
+			m.annotate(&spec.Template.ObjectMeta, &spec.Template.Spec)
+			o.Spec.Template = spec
+		case api.PodSpec:
+			m.annotate(&o.ObjectMeta, &spec)
+		}
+	}
+	return nil
+}
+
+// annotate sets the checksum annotation in meta when spec uses any of the
+// generated config maps or secrets.
+func (m configChecksumMutator) annotate(meta *api.ObjectMeta, spec *api.PodSpec) {
+	checksum := m.checksum(spec)
+	if checksum == "" {
+		return
+	}
+	if meta.Annotations == nil {
+		meta.Annotations = make(map[string]string)
+	}
+	meta.Annotations[configChecksumAnnotation] = checksum
+}
+
+// checksum hashes the names and data of the generated config maps and
+// secrets mounted by the pods or referenced by their environment, in name
+// and key order. It returns an empty string when none are used.
+func (m configChecksumMutator) checksum(spec *api.PodSpec) string {
+	usedConfigMaps := make(map[string]bool)
+	usedSecrets := make(map[string]bool)
+	for _, volume := range spec.Volumes {
+		switch {
+		case volume.ConfigMap != nil:
+			usedConfigMaps[volume.ConfigMap.Name] = true
+		case volume.Secret != nil:
+			usedSecrets[volume.Secret.SecretName] = true
+		}
+	}
+	for _, container := range spec.Containers {
+		for _, env := range container.Env {
+			switch {
+			case env.ValueFrom == nil:
+			case env.ValueFrom.ConfigMapKeyRef != nil:
+				usedConfigMaps[env.ValueFrom.ConfigMapKeyRef.Name] = true
+			case env.ValueFrom.SecretKeyRef != nil:
+				usedSecrets[env.ValueFrom.SecretKeyRef.Name] = true
+			}
+		}
+	}
+	var configMaps, secrets []string
+	for name := range usedConfigMaps {
+		if _, ok := m.configMaps[name]; ok {
+			configMaps = append(configMaps, name)
+		}
+	}
+	for name := range usedSecrets {
+		if _, ok := m.secrets[name]; ok {
+			secrets = append(secrets, name)
+		}
+	}
+	if len(configMaps) == 0 && len(secrets) == 0 {
+		return ""
+	}
+	sort.Strings(configMaps)
+	sort.Strings(secrets)
+
+	hash := sha256.New()
+	for _, name := range configMaps {
+		data := m.configMaps[name]
+		hash.Write([]byte("configmap\x00" + name + "\x00"))
+		for _, key := range sortedStringKeys(data) {
+			hash.Write([]byte(key + "\x00" + data[key] + "\x00"))
+		}
+	}
+	for _, name := range secrets {
+		data := m.secrets[name]
+		hash.Write([]byte("secret\x00" + name + "\x00"))
+		keys := make([]string, 0, len(data))
+		for key := range data {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			hash.Write([]byte(key + "\x00"))
+			hash.Write(data[key])
+			hash.Write([]byte{0})
+		}
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// sortedStringKeys returns the keys of m in order.
+func sortedStringKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2015 Kelsey Hightower All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package convert
+
+import (
+	"testing"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/apis/apps"
+	"k8s.io/kubernetes/pkg/apis/extensions"
+)
+
+const withConfig = `version: "3.3"
+services:
+  web:
+    image: nginx
+    configs:
+      - source: app_config
+        target: /etc/app.conf
+configs:
+  app_config:
+    file: ./app.conf
+`
+
+// configChecksum returns the checksum annotation of the controller of the
+// web service, converted with the given config data.
+func configChecksum(t *testing.T, data string, opts Options) string {
+	t.Helper()
+	result := convertProject(t, withConfig, map[string]string{"app.conf": data}, opts)
+	var controller Object
+	for _, obj := range result.Objects {
+		if obj.Service == "web" {
+			controller = obj
+		}
+	}
+	var meta api.ObjectMeta
+	switch o := controller.Object.(type) {
+	case *api.ReplicationController:
+		meta = o.Spec.Template.ObjectMeta
+	case *extensions.Deployment:
+		meta = o.Spec.Template.ObjectMeta
+	case *apps.StatefulSet:
+		meta = o.Spec.Template.ObjectMeta
+	case *api.Pod:
+		meta = o.ObjectMeta
+	case *customResource:
+		switch spec := o.Spec.Template.(type) {
+		case api.ReplicationControllerSpec:
+			meta = spec.Template.ObjectMeta
+		case extensions.DeploymentSpec:
+			meta = spec.Template.ObjectMeta
+		case apps.StatefulSetSpec:
+			meta = spec.Template.ObjectMeta
+		case api.PodSpec:
+			meta = o.ObjectMeta
+		default:
+			t.Fatalf("unexpected custom resource spec %T", spec)
+		}
+	default:
+		t.Fatalf("unexpected controller %T", o)
+	}
+	return meta.Annotations[configChecksumAnnotation]
+}
+
+func TestConfigChecksum(t *testing.T) {
+	crd := &unversioned.GroupVersionKind{Group: "platform.example.com", Version: "v1", Kind: "Workload"}
+	for _, controller := range []string{ControllerRC, ControllerDeployment, ControllerStatefulSet, ControllerPod} {
+		for _, wrap := range []*unversioned.GroupVersionKind{nil, crd} {
+			opts := Options{Controller: controller, WrapCRD: wrap}
+			name := controller
+			if wrap != nil {
+				name += " wrapped"
+			}
+			first := configChecksum(t, "listen 80\n", opts)
+			if first == "" {
+				t.Errorf("%s: got no checksum annotation", name)
+				continue
+			}
+			if again := configChecksum(t, "listen 80\n", opts); again != first {
+				t.Errorf("%s: got checksum %s for the same config, want %s", name, again, first)
+			}
+			if changed := configChecksum(t, "listen 8080\n", opts); changed == first {
+				t.Errorf("%s: got the same checksum %s after changing the config", name, changed)
+			}
+		}
+	}
+}
@@ -0,0 +1,136 @@
+/*
+Copyright 2015 Kelsey Hightower All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package convert
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"k8s.io/kubernetes/pkg/api"
+)
+
+func TestEmptyCommand(t *testing.T) {
+	tests := []struct {
+		command string
+		want    []string
+	}{
+		{`""`, nil},
+		{`"   "`, nil},
+		{`[]`, nil},
+		{`[""]`, nil},
+		{`["nginx", "-g", "daemon off;"]`, []string{"nginx", "-g", "daemon off;"}},
+	}
+	for _, test := range tests {
+		result := convertProject(t, `version: "2"
+services:
+  web:
+    image: nginx
+    command: `+test.command+`
+`, nil, Options{})
+		container := podSpec(t, result, "web").Containers[0]
+		if !reflect.DeepEqual(container.Command, test.want) {
+			t.Errorf("command %s: got container command %q, want %q", test.command, container.Command, test.want)
+		}
+	}
+}
+
+func TestShellCommand(t *testing.T) {
+	tests := []struct {
+		command string
+		want    []string
+	}{
+		// A string is run by the shell, so its quoting and operators work
+		// as they do with docker-compose.
+		{`echo "hello world" && sleep 60`, []string{"/bin/sh", "-c", `echo "hello world" && sleep 60`}},
+		{`nginx -g 'daemon off;'`, []string{"/bin/sh", "-c", `nginx -g 'daemon off;'`}},
+		// A list is the exec form, run as it is.
+		{`["sh", "-c", "echo hi"]`, []string{"sh", "-c", "echo hi"}},
+		{`["nginx", "-g", "daemon off;"]`, []string{"nginx", "-g", "daemon off;"}},
+	}
+	for _, test := range tests {
+		data, err := json.Marshal(test.command)
+		if err != nil {
+			t.Fatal(err)
+		}
+		command := string(data)
+		if strings.HasPrefix(test.command, "[") {
+			command = test.command
+		}
+		result := convertProject(t, `version: "2"
+services:
+  web:
+    image: nginx
+    command: `+command+`
+`, nil, Options{})
+		container := podSpec(t, result, "web").Containers[0]
+		if !reflect.DeepEqual(container.Command, test.want) || container.Args != nil {
+			t.Errorf("command %s: got container command %q and args %q, want command %q", test.command, container.Command, container.Args, test.want)
+		}
+	}
+}
+
+func TestInit(t *testing.T) {
+	const compose = `version: "2.2"
+services:
+  command:
+    image: app
+    init: true
+    command: ["serve", "--port", "80"]
+  entrypoint:
+    image: app
+    init: true
+    entrypoint: ["/entrypoint.sh"]
+    command: ["serve"]
+  image:
+    image: app
+    init: true
+  plain:
+    image: app
+    command: ["serve"]
+`
+	tests := []struct {
+		service string
+		inject  bool
+		command []string
+		args    []string
+		init    bool
+	}{
+		// Without -inject-tini the request is only recorded.
+		{"command", false, []string{"serve", "--port", "80"}, nil, true},
+		{"command", true, []string{"tini", "--", "serve", "--port", "80"}, nil, true},
+		// tini runs the entrypoint, which keeps its arguments.
+		{"entrypoint", true, []string{"tini", "--", "/entrypoint.sh"}, []string{"serve"}, true},
+		// The entrypoint of the image is unknown, so tini cannot run it.
+		{"image", true, nil, nil, true},
+		{"plain", true, []string{"serve"}, nil, false},
+	}
+	for _, test := range tests {
+		result := convertProject(t, compose, nil, Options{InjectTini: test.inject})
+		rc := findObject(t, result, "ReplicationController", test.service).Object.(*api.ReplicationController)
+		container := rc.Spec.Template.Spec.Containers[0]
+		if !reflect.DeepEqual(container.Command, test.command) || !reflect.DeepEqual(container.Args, test.args) {
+			t.Errorf("%s with tini %t: got command %q and args %q, want %q and %q", test.service, test.inject, container.Command, container.Args, test.command, test.args)
+		}
+		if _, ok := rc.Spec.Template.Annotations[initAnnotation]; ok != test.init {
+			t.Errorf("%s with tini %t: got init annotation %t, want %t", test.service, test.inject, ok, test.init)
+		}
+	}
+
+	result := convertProject(t, compose, nil, Options{InjectTini: true})
+	if got := result.Report.Services["image"].Warnings; len(got) != 1 {
+		t.Errorf("got warnings %q, want one for the missing command", got)
+	}
+}
@@ -0,0 +1,501 @@
+/*
+Copyright 2015 Kelsey Hightower All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package convert
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+// serviceExtras holds the service options that libcompose does not parse.
+// They are removed from the compose file before it is handed to libcompose
+// and decoded separately.
+type serviceExtras struct {
+	BlkioConfig       interface{}                `yaml:"blkio_config"`
+	CPUCount          int64                      `yaml:"cpu_count"`
+	CPUPercent        int64                      `yaml:"cpu_percent"`
+	Deploy            *deployConfig              `yaml:"deploy"`
+	DependsOn         map[string]dependsOnConfig `yaml:"depends_on"`
+	DeviceCgroupRules []string                   `yaml:"device_cgroup_rules"`
+	EnvFile           envFiles                   `yaml:"env_file"`
+	OomKillDisable    bool                       `yaml:"oom_kill_disable"`
+	OomScoreAdj       *int                       `yaml:"oom_score_adj"`
+	ShellCommand      string                     `yaml:"command"`
+	Secrets           []fileObjectRef            `yaml:"secrets"`
+	ShmSize           string                     `yaml:"shm_size"`
+	Configs           []fileObjectRef            `yaml:"configs"`
+	Healthcheck       *healthcheckConfig         `yaml:"healthcheck"`
+	Init              bool                       `yaml:"init"`
+	Isolation         string                     `yaml:"isolation"`
+	MemLimit          string                     `yaml:"mem_limit"`
+	MemReservation    string                     `yaml:"mem_reservation"`
+	MemSwappiness     interface{}                `yaml:"mem_swappiness"`
+	Platform          string                     `yaml:"platform"`
+	Profiles          []string                   `yaml:"profiles"`
+	Runtime           string                     `yaml:"runtime"`
+	StopGracePeriod   string                     `yaml:"stop_grace_period"`
+	StopSignal        string                     `yaml:"stop_signal"`
+	Sysctls           sysctlsConfig              `yaml:"sysctls"`
+	Volumes           []serviceVolume            `yaml:"volumes"`
+}
+
+// extraKeys lists the service options decoded into serviceExtras.
+var extraKeys = []string{
+	"blkio_config",
+	"configs",
+	"cpu_count",
+	"cpu_percent",
+	"deploy",
+	"device_cgroup_rules",
+	"env_file",
+	"healthcheck",
+	"init",
+	"isolation",
+	"mem_limit",
+	"mem_reservation",
+	"mem_swappiness",
+	"oom_kill_disable",
+	"oom_score_adj",
+	"platform",
+	"profiles",
+	"runtime",
+	"secrets",
+	"shm_size",
+	"stop_grace_period",
+	"stop_signal",
+	"sysctls",
+}
+
+type deployConfig struct {
+	Replicas      *int32          `yaml:"replicas"`
+	Resources     deployResources `yaml:"resources"`
+	UpdateConfig  *updateConfig   `yaml:"update_config"`
+	Placement     placement       `yaml:"placement"`
+	RestartPolicy *restartPolicy  `yaml:"restart_policy"`
+}
+
+type restartPolicy struct {
+	Condition   string `yaml:"condition"`
+	MaxAttempts *int   `yaml:"max_attempts"`
+}
+
+type placement struct {
+	Constraints []string `yaml:"constraints"`
+}
+
+type updateConfig struct {
+	Parallelism *int   `yaml:"parallelism"`
+	Order       string `yaml:"order"`
+}
+
+type deployResources struct {
+	Limits       *deployResourceSpec `yaml:"limits"`
+	Reservations *deployResourceSpec `yaml:"reservations"`
+}
+
+type deployResourceSpec struct {
+	CPUs   string `yaml:"cpus"`
+	Memory string `yaml:"memory"`
+}
+
+// composeExtras holds the parts of a compose file that libcompose does not
+// parse.
+type composeExtras struct {
+	Version  string                      `yaml:"-"`
+	Services map[string]serviceExtras    `yaml:"-"`
+	Secrets  map[string]fileObjectConfig `yaml:"secrets"`
+	Configs  map[string]fileObjectConfig `yaml:"configs"`
+}
+
+// topLevelExtraKeys lists the top-level options decoded into composeExtras.
+var topLevelExtraKeys = []string{
+	"configs",
+	"secrets",
+}
+
+// Standard compose file names, in the order docker-compose looks for them.
+var (
+	defaultComposeFiles  = []string{"docker-compose.yml", "docker-compose.yaml"}
+	defaultOverrideFiles = []string{"docker-compose.override.yml", "docker-compose.override.yaml"}
+)
+
+// composeFiles returns the compose files to load for path. A directory is
+// searched for the standard compose file and its optional override file.
+func composeFiles(path string) ([]string, error) {
+	info, err := os.Stat(path)
+	if err != nil || !info.IsDir() {
+		return []string{path}, nil
+	}
+
+	find := func(names []string) string {
+		for _, name := range names {
+			if _, err := os.Stat(filepath.Join(path, name)); err == nil {
+				return filepath.Join(path, name)
+			}
+		}
+		return ""
+	}
+	base := find(defaultComposeFiles)
+	if base == "" {
+		return nil, fmt.Errorf("no %s found in %s", strings.Join(defaultComposeFiles, " or "), path)
+	}
+	files := []string{base}
+	if override := find(defaultOverrideFiles); override != "" {
+		files = append(files, override)
+	}
+	return files, nil
+}
+
+// loadCompose reads the compose files in paths, merging each into the ones
+// before it, substitutes variables and splits off the options libcompose does
+// not understand. It returns the remaining compose document and the extra
+// options.
+func (c *converter) loadCompose(paths []string) ([]byte, *composeExtras, error) {
+	var doc map[interface{}]interface{}
+	dependsOnOrder := make(map[string][]string)
+	for _, path := range paths {
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, nil, err
+		}
+		var override map[interface{}]interface{}
+		if err := yaml.Unmarshal(data, &override); err != nil {
+			return nil, nil, fmt.Errorf("%s: %v", path, err)
+		}
+		if err := recordDependsOnOrder(data, dependsOnOrder); err != nil {
+			return nil, nil, fmt.Errorf("%s: %v", path, err)
+		}
+		normalizeLabels(override)
+		doc = mergeMaps(doc, override)
+	}
+	// Service environments are substituted on their own so that entries can
+	// reference the variables defined before them.
+	environments := make(map[interface{}]interface{})
+	for name, value := range composeServices(doc) {
+		if service, ok := value.(map[interface{}]interface{}); ok {
+			if env, ok := service["environment"]; ok {
+				environments[name] = env
+				delete(service, "environment")
+			}
+		}
+	}
+	if _, err := c.interpolateValue(doc); err != nil {
+		return nil, nil, err
+	}
+	for name, env := range environments {
+		env, err := c.interpolateEnvironment(env)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%v: environment: %v", name, err)
+		}
+		composeServices(doc)[name].(map[interface{}]interface{})["environment"] = env
+	}
+
+	// The version 1 format has no version key.
+	extras := &composeExtras{Version: "1"}
+	if version, ok := doc["version"]; ok {
+		extras.Version = fmt.Sprint(version)
+	}
+	raw := make(map[interface{}]interface{})
+	for _, key := range topLevelExtraKeys {
+		if v, ok := doc[key]; ok {
+			raw[key] = v
+			delete(doc, key)
+		}
+	}
+	if err := remarshal(unescapeDollars(raw), extras); err != nil {
+		return nil, nil, err
+	}
+	services, err := splitExtras(doc, dependsOnOrder)
+	if err != nil {
+		return nil, nil, err
+	}
+	extras.Services = services
+
+	// The claims of the named volumes are created from the service volumes,
+	// so libcompose does not need the top-level volumes. It would crash on a
+	// volume declared without options and prefix the names of the others
+	// with the project name.
+	delete(doc, "volumes")
+
+	// libcompose also crashes on a network declared without options, which
+	// is the same as a network with none.
+	if networks, ok := doc["networks"].(map[interface{}]interface{}); ok {
+		for name, network := range networks {
+			if network == nil {
+				networks[name] = map[interface{}]interface{}{}
+			}
+		}
+	}
+
+	// libcompose only parses the version 2 format, and takes the minor
+	// versions of 2 for version 1. With the options of the later versions
+	// split off, the remainder of a version 2.x or 3 file is also valid
+	// version 2.
+	if version, ok := doc["version"].(string); ok && (strings.HasPrefix(version, "2.") || strings.HasPrefix(version, "3")) {
+		doc["version"] = "2"
+	}
+
+	data, err := yaml.Marshal(doc)
+	if err != nil {
+		return nil, nil, err
+	}
+	return data, extras, nil
+}
+
+// appendKeys lists the options whose lists are concatenated rather than
+// replaced when an override file is merged, as docker-compose does.
+var appendKeys = map[interface{}]bool{
+	"devices":        true,
+	"dns":            true,
+	"dns_search":     true,
+	"environment":    true,
+	"expose":         true,
+	"external_links": true,
+	"ports":          true,
+	"tmpfs":          true,
+	"volumes":        true,
+}
+
+// mergeMaps merges the compose document override into base. Maps are merged
+// recursively, the lists of appendKeys are concatenated and any other value
+// in override replaces the one in base.
+func mergeMaps(base, override map[interface{}]interface{}) map[interface{}]interface{} {
+	if base == nil {
+		return override
+	}
+	for key, value := range override {
+		switch v := value.(type) {
+		case map[interface{}]interface{}:
+			if b, ok := base[key].(map[interface{}]interface{}); ok {
+				value = mergeMaps(b, v)
+			}
+		case []interface{}:
+			if b, ok := base[key].([]interface{}); ok && appendKeys[key] {
+				value = append(b, v...)
+			}
+		}
+		base[key] = value
+	}
+	return base
+}
+
+// normalizeLabels turns the labels of every service in doc given as a list
+// of key=value entries into a map, so that labels given in either form are
+// merged by key across files. An entry without = is a label with an empty
+// value.
+func normalizeLabels(doc map[interface{}]interface{}) {
+	for _, value := range composeServices(doc) {
+		service, ok := value.(map[interface{}]interface{})
+		if !ok {
+			continue
+		}
+		entries, ok := service["labels"].([]interface{})
+		if !ok {
+			continue
+		}
+		labels := make(map[interface{}]interface{})
+		for _, entry := range entries {
+			parts := strings.SplitN(fmt.Sprint(entry), "=", 2)
+			key := strings.TrimSpace(parts[0])
+			if len(parts) == 2 {
+				labels[key] = parts[1]
+			} else {
+				labels[key] = ""
+			}
+		}
+		service["labels"] = labels
+	}
+}
+
+// remarshal decodes a generic YAML value into out.
+func remarshal(in interface{}, out interface{}) error {
+	data, err := yaml.Marshal(in)
+	if err != nil {
+		return err
+	}
+	return yaml.Unmarshal(data, out)
+}
+
+// composeServices returns the service definitions of a compose document. The
+// version 1 format has no version key and keeps services at the top level.
+func composeServices(doc map[interface{}]interface{}) map[interface{}]interface{} {
+	if _, ok := doc["version"]; !ok {
+		return doc
+	}
+	services, _ := doc["services"].(map[interface{}]interface{})
+	return services
+}
+
+// recordDependsOnOrder appends the services of the long form of depends_on of
+// each service in the compose file data to order, in the order they are
+// declared, which decoding the file into a map loses. Services already in
+// order keep their position.
+func recordDependsOnOrder(data []byte, order map[string][]string) error {
+	var doc yaml.MapSlice
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return err
+	}
+	services := doc
+	for _, item := range doc {
+		if item.Key == "version" {
+			services, _ = mapSliceValue(doc, "services")
+			break
+		}
+	}
+	for _, service := range services {
+		config, ok := service.Value.(yaml.MapSlice)
+		if !ok {
+			continue
+		}
+		dependsOn, ok := mapSliceValue(config, "depends_on")
+		if !ok {
+			continue
+		}
+		name := fmt.Sprint(service.Key)
+		seen := make(map[string]bool)
+		for _, dep := range order[name] {
+			seen[dep] = true
+		}
+		for _, dep := range dependsOn {
+			if key := fmt.Sprint(dep.Key); !seen[key] {
+				order[name] = append(order[name], key)
+				seen[key] = true
+			}
+		}
+	}
+	return nil
+}
+
+// mapSliceValue returns the value of key in m when it is a map.
+func mapSliceValue(m yaml.MapSlice, key string) (yaml.MapSlice, bool) {
+	for _, item := range m {
+		if item.Key == key {
+			value, ok := item.Value.(yaml.MapSlice)
+			return value, ok
+		}
+	}
+	return nil, false
+}
+
+// splitExtras removes the options listed in extraKeys from every service in
+// doc and decodes them. The long form of depends_on is listed in
+// dependsOnOrder, the order its services are declared in.
+func splitExtras(doc map[interface{}]interface{}, dependsOnOrder map[string][]string) (map[string]serviceExtras, error) {
+	extras := make(map[string]serviceExtras)
+	for key, value := range composeServices(doc) {
+		name := fmt.Sprint(key)
+		service, ok := value.(map[interface{}]interface{})
+		if !ok {
+			continue
+		}
+
+		raw := make(map[interface{}]interface{})
+		for _, extraKey := range extraKeys {
+			if v, ok := service[extraKey]; ok {
+				raw[extraKey] = v
+				delete(service, extraKey)
+			}
+		}
+		// libcompose splits a command given as a string into words, so the
+		// string is kept as well to run it by the shell.
+		if command, ok := service["command"].(string); ok {
+			raw["command"] = command
+			// libcompose fails on a blank command, which is unset anyway.
+			if strings.TrimSpace(command) == "" {
+				delete(service, "command")
+			}
+		}
+		// libcompose only parses the list form of depends_on, so the
+		// conditions of the long form are split off and the service is
+		// left with the list of its dependencies.
+		if dependsOn, ok := service["depends_on"].(map[interface{}]interface{}); ok {
+			list := make([]interface{}, 0, len(dependsOn))
+			for _, dep := range dependsOnOrder[name] {
+				if _, ok := dependsOn[dep]; ok {
+					list = append(list, dep)
+				}
+			}
+			raw["depends_on"] = dependsOn
+			service["depends_on"] = list
+		}
+		// libcompose only parses the short volume syntax, so only the
+		// volumes in the long syntax are split off.
+		if volumes, ok := service["volumes"].([]interface{}); ok {
+			var short, long []interface{}
+			for _, v := range volumes {
+				if _, ok := v.(map[interface{}]interface{}); ok {
+					long = append(long, v)
+				} else {
+					short = append(short, v)
+				}
+			}
+			if len(long) > 0 {
+				raw["volumes"] = long
+				service["volumes"] = short
+			}
+		}
+		if len(raw) == 0 {
+			continue
+		}
+
+		var e serviceExtras
+		if err := remarshal(unescapeDollars(raw), &e); err != nil {
+			return nil, fmt.Errorf("service %s: %v", name, err)
+		}
+		extras[name] = e
+	}
+	return extras, nil
+}
+
+// optionVersions maps service options introduced after the version 1 format
+// to the first version accepting them. Versions are compared as strings,
+// which is enough for the versions listed.
+var optionVersions = map[string]string{
+	"depends_on":   "2",
+	"group_add":    "2",
+	"healthcheck":  "2.1",
+	"network_mode": "2",
+	"networks":     "2",
+	"sysctls":      "2.1",
+	"tmpfs":        "2",
+	"userns_mode":  "2.1",
+}
+
+// unsupportedOption extracts the option name from the libcompose error about
+// an option the declared format does not accept.
+var unsupportedOption = regexp.MustCompile(`Unsupported config option for (\S+) service: '([^']+)'`)
+
+// versionHint suggests a fix for a libcompose parse error caused by options
+// that the declared compose format does not accept. It returns an empty
+// string when the error is not recognized.
+func versionHint(err error, version string) string {
+	match := unsupportedOption.FindStringSubmatch(err.Error())
+	if match == nil {
+		return ""
+	}
+	service, option := match[1], match[2]
+	if version == "1" && service == "services" {
+		return ", the services key requires a version, add version: \"2\" or later at the top of the file"
+	}
+	if required, ok := optionVersions[option]; ok && version < required {
+		return fmt.Sprintf(", %s requires version %s or later, the file declares version %s", option, required, version)
+	}
+	return ""
+}
@@ -0,0 +1,136 @@
+/*
+Copyright 2015 Kelsey Hightower All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package convert
+
+import (
+	"errors"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"k8s.io/kubernetes/pkg/api"
+)
+
+func TestComposeFiles(t *testing.T) {
+	dir := filepath.Join("testdata", "override")
+	files, err := composeFiles(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{
+		filepath.Join(dir, "docker-compose.yml"),
+		filepath.Join(dir, "docker-compose.override.yml"),
+	}
+	if !reflect.DeepEqual(files, want) {
+		t.Errorf("got files %q, want %q", files, want)
+	}
+
+	// A file is converted on its own.
+	files, err = composeFiles(want[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(files, want[:1]) {
+		t.Errorf("got files %q, want %q", files, want[:1])
+	}
+
+	if _, err := composeFiles(t.TempDir()); err == nil {
+		t.Error("composeFiles of a directory without a compose file succeeded, want an error")
+	}
+}
+
+func TestComposeDirectory(t *testing.T) {
+	result := convertFixture(t, "override", Options{})
+
+	// The override replaces single values and extends lists, like
+	// docker-compose.
+	container := podSpec(t, result, "web").Containers[0]
+	if container.Image != "nginx:1.12" {
+		t.Errorf("got image %s, want nginx:1.12 from the override", container.Image)
+	}
+	wantPorts := []api.ContainerPort{{ContainerPort: 80}, {ContainerPort: 8080}}
+	if !reflect.DeepEqual(container.Ports, wantPorts) {
+		t.Errorf("got ports %s, want %s", toJSON(container.Ports), toJSON(wantPorts))
+	}
+	wantEnv := []api.EnvVar{{Name: "MODE", Value: "production"}, {Name: "DEBUG", Value: "1"}}
+	if !reflect.DeepEqual(container.Env, wantEnv) {
+		t.Errorf("got env %s, want %s", toJSON(container.Env), toJSON(wantEnv))
+	}
+
+	// Services only in the base file are converted too.
+	findObject(t, result, "ReplicationController", "database")
+}
+
+func TestComposeVersionAnnotation(t *testing.T) {
+	tests := []struct {
+		compose string
+		want    string
+	}{
+		{"version: \"3.8\"\nservices:\n  web:\n    image: nginx\n    ports:\n      - \"80\"\n    labels:\n      kompose.service.kind: webapp\n", "3.8"},
+		{"version: 2\nservices:\n  web:\n    image: nginx\n", "2"},
+		// The version 1 format has no version key.
+		{"web:\n  image: nginx\n", "1"},
+	}
+	for _, test := range tests {
+		result := convertProject(t, test.compose, nil, Options{})
+		if len(result.Objects) == 0 {
+			t.Fatalf("%q: got no objects", test.compose)
+		}
+		// Every object records the format it was generated from.
+		for _, obj := range result.Objects {
+			if got := annotations(t, obj)[composeVersionAnnotation]; got != test.want {
+				t.Errorf("%q: got %s %s version %q, want %q", test.compose, obj.Kind, obj.Name, got, test.want)
+			}
+		}
+	}
+}
+
+func TestVersionHint(t *testing.T) {
+	tests := []struct {
+		compose string
+		want    string
+	}{
+		{"web:\n  image: nginx\n  depends_on:\n    - db\ndb:\n  image: postgres\n",
+			"depends_on requires version 2 or later, the file declares version 1"},
+		{"web:\n  image: nginx\n  network_mode: host\n",
+			"network_mode requires version 2 or later, the file declares version 1"},
+		{"services:\n  web:\n    image: nginx\n",
+			"the services key requires a version"},
+	}
+	for _, test := range tests {
+		_, err := Convert(writeProject(t, test.compose, nil), Options{})
+		if err == nil || !strings.Contains(err.Error(), test.want) {
+			t.Errorf("%q: got error %v, want one containing %q", test.compose, err, test.want)
+		}
+	}
+
+	err := errors.New("Unsupported config option for web service: 'sysctls'")
+	if got, want := versionHint(err, "2"), ", sysctls requires version 2.1 or later, the file declares version 2"; got != want {
+		t.Errorf("got hint %q, want %q", got, want)
+	}
+
+	// Other errors get no hint.
+	for _, err := range []error{
+		errors.New("Unsupported config option for web service: 'build_args'"),
+		errors.New("invalid port"),
+	} {
+		if got := versionHint(err, "2"); got != "" {
+			t.Errorf("versionHint(%v) = %q, want none", err, got)
+		}
+	}
+	if got := versionHint(errors.New("Unsupported config option for web service: 'sysctls'"), "2.1"); got != "" {
+		t.Errorf("got hint %q for a version accepting sysctls, want none", got)
+	}
+}
@@ -0,0 +1,39 @@
+/*
+Copyright 2015 Kelsey Hightower All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package convert
+
+import (
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+)
+
+// newConfigMap creates a ConfigMap holding the content of a file-based
+// compose config under a key named after the config.
+func newConfigMap(name string, config fileObjectConfig, baseDir string) (*api.ConfigMap, error) {
+	data, err := readFileObject(config, baseDir)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.ConfigMap{
+		TypeMeta: unversioned.TypeMeta{
+			Kind:       "ConfigMap",
+			APIVersion: "v1",
+		},
+		ObjectMeta: api.ObjectMeta{
+			Name: kubeName(name, config),
+		},
+		Data: map[string]string{name: string(data)},
+	}, nil
+}
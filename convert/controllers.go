@@ -0,0 +1,267 @@
+/*
+Copyright 2015 Kelsey Hightower All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package convert
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/apis/apps"
+	"k8s.io/kubernetes/pkg/apis/batch"
+	"k8s.io/kubernetes/pkg/apis/extensions"
+	"k8s.io/kubernetes/pkg/util/intstr"
+)
+
+// Kinds of controller Convert can create for each service.
+const (
+	ControllerRC          = "rc"
+	ControllerPod         = "pod"
+	ControllerDeployment  = "deployment"
+	ControllerJob         = "job"
+	ControllerStatefulSet = "statefulset"
+)
+
+// inferController picks the controller for a service from its restart
+// policy. Services restarted until stopped are long-running and get a
+// deployment. Services never restarted run once as a bare pod, while those
+// restarted on failure run to completion as a job.
+func inferController(restart string) string {
+	switch restart {
+	case "no":
+		return ControllerPod
+	case "on-failure":
+		return ControllerJob
+	default:
+		return ControllerDeployment
+	}
+}
+
+// newPod creates a bare pod from the pod template of rc. The annotations of
+// the controller are carried over to the pod.
+func newPod(rc *api.ReplicationController) *api.Pod {
+	meta := rc.Spec.Template.ObjectMeta
+	meta.Name = rc.Name
+	meta.Annotations = make(map[string]string)
+	for k, v := range rc.Spec.Template.Annotations {
+		meta.Annotations[k] = v
+	}
+	for k, v := range rc.Annotations {
+		meta.Annotations[k] = v
+	}
+
+	return &api.Pod{
+		TypeMeta: unversioned.TypeMeta{
+			Kind:       "Pod",
+			APIVersion: "v1",
+		},
+		ObjectMeta: meta,
+		Spec:       rc.Spec.Template.Spec,
+	}
+}
+
+// newDeployment creates a deployment with the same replicas, selector and pod
+// template as rc.
+func newDeployment(rc *api.ReplicationController) *extensions.Deployment {
+	return &extensions.Deployment{
+		TypeMeta: unversioned.TypeMeta{
+			Kind:       "Deployment",
+			APIVersion: "extensions/v1beta1",
+		},
+		ObjectMeta: rc.ObjectMeta,
+		Spec: extensions.DeploymentSpec{
+			Replicas: rc.Spec.Replicas,
+			Selector: &unversioned.LabelSelector{MatchLabels: rc.Spec.Selector},
+			Template: *rc.Spec.Template,
+		},
+	}
+}
+
+// newStatefulSet creates a stateful set with the same replicas, selector and
+// pod template as rc, governed by the headless service serviceName.
+func newStatefulSet(rc *api.ReplicationController, serviceName string) *apps.StatefulSet {
+	return &apps.StatefulSet{
+		TypeMeta: unversioned.TypeMeta{
+			Kind:       "StatefulSet",
+			APIVersion: "apps/v1beta1",
+		},
+		ObjectMeta: rc.ObjectMeta,
+		Spec: apps.StatefulSetSpec{
+			Replicas:    rc.Spec.Replicas,
+			Selector:    &unversioned.LabelSelector{MatchLabels: rc.Spec.Selector},
+			Template:    *rc.Spec.Template,
+			ServiceName: serviceName,
+		},
+	}
+}
+
+// progressDeadlineLabel sets the seconds a deployment may take to make
+// progress before its rollout is reported as failed.
+const progressDeadlineLabel = "kompose.deployment.progressDeadline"
+
+// progressDeadline parses the value of the progressDeadlineLabel label.
+func progressDeadline(value string) (*int32, error) {
+	seconds, err := strconv.ParseInt(value, 10, 32)
+	if err != nil || seconds <= 0 {
+		return nil, fmt.Errorf("%q is not a positive integer", value)
+	}
+	deadline := int32(seconds)
+	return &deadline, nil
+}
+
+// revisionHistoryLimitLabel sets the number of old replica sets kept for
+// rolling back a deployment.
+const revisionHistoryLimitLabel = "kompose.deployment.revisionHistoryLimit"
+
+// revisionHistoryLimit parses the value of the revisionHistoryLimitLabel
+// label.
+func revisionHistoryLimit(value string) (*int32, error) {
+	limit, err := strconv.ParseInt(value, 10, 32)
+	if err != nil || limit < 0 {
+		return nil, fmt.Errorf("%q is not a non-negative integer", value)
+	}
+	history := int32(limit)
+	return &history, nil
+}
+
+// deploymentStrategy approximates a swarm update_config with a rolling update.
+// Swarm updates parallelism tasks at a time, either stopping the old tasks
+// first or starting the new ones first. Stopping first maps to allowing
+// parallelism unavailable pods without surge, starting first to a surge of
+// parallelism pods while keeping all existing pods available. A parallelism
+// of 0 updates all tasks at once.
+func deploymentStrategy(update *updateConfig) (extensions.DeploymentStrategy, error) {
+	parallelism := intstr.FromInt(1)
+	if update.Parallelism != nil {
+		switch p := *update.Parallelism; {
+		case p < 0:
+			return extensions.DeploymentStrategy{}, fmt.Errorf("invalid parallelism %d", p)
+		case p == 0:
+			parallelism = intstr.FromString("100%")
+		default:
+			parallelism = intstr.FromInt(p)
+		}
+	}
+
+	rollingUpdate := &extensions.RollingUpdateDeployment{}
+	switch update.Order {
+	case "", "stop-first":
+		rollingUpdate.MaxUnavailable = parallelism
+		rollingUpdate.MaxSurge = intstr.FromInt(0)
+	case "start-first":
+		rollingUpdate.MaxUnavailable = intstr.FromInt(0)
+		rollingUpdate.MaxSurge = parallelism
+	default:
+		return extensions.DeploymentStrategy{}, fmt.Errorf("unknown order %s", update.Order)
+	}
+
+	return extensions.DeploymentStrategy{
+		Type:          extensions.RollingUpdateDeploymentStrategyType,
+		RollingUpdate: rollingUpdate,
+	}, nil
+}
+
+// newJob creates a job running the pod template of rc to completion. Jobs
+// cannot restart their pods forever, so the restart policy is taken from
+// the swarm restart policy when given: none maps to Never, and on-failure
+// and any map to OnFailure. Otherwise the restart policy of the template is
+// used, with Always downgraded to OnFailure.
+func (c *converter) newJob(service string, rc *api.ReplicationController, policy *restartPolicy) (*batch.Job, error) {
+	template := *rc.Spec.Template
+	condition := ""
+	if policy != nil {
+		condition = policy.Condition
+	}
+	switch condition {
+	case "none":
+		template.Spec.RestartPolicy = api.RestartPolicyNever
+	case "on-failure":
+		template.Spec.RestartPolicy = api.RestartPolicyOnFailure
+	case "any":
+		c.report.serviceWarnf(service, "Restarting the job of service %s on failure only, jobs cannot restart pods that succeeded", service)
+		template.Spec.RestartPolicy = api.RestartPolicyOnFailure
+	case "":
+		if template.Spec.RestartPolicy == api.RestartPolicyAlways {
+			template.Spec.RestartPolicy = api.RestartPolicyOnFailure
+		}
+	default:
+		return nil, fmt.Errorf("unknown restart condition %s", condition)
+	}
+	if policy != nil && policy.MaxAttempts != nil {
+		c.report.skipf(service, "deploy.restart_policy.max_attempts", "Ignoring max_attempts for service %s, jobs have no retry limit in this API version", service)
+	}
+
+	return &batch.Job{
+		TypeMeta: unversioned.TypeMeta{
+			Kind:       "Job",
+			APIVersion: "batch/v1",
+		},
+		ObjectMeta: rc.ObjectMeta,
+		Spec: batch.JobSpec{
+			Template: template,
+		},
+	}, nil
+}
+
+// FragmentPodTemplate outputs the pod template of each service instead of
+// its controller.
+const FragmentPodTemplate = "podtemplate"
+
+// podTemplateFragment is a pod template spec output on its own. It has no
+// type information, as it is meant to be embedded in other objects.
+type podTemplateFragment struct {
+	api.PodTemplateSpec
+}
+
+// GetObjectKind implements runtime.Object.
+func (f *podTemplateFragment) GetObjectKind() unversioned.ObjectKind {
+	return unversioned.EmptyObjectKind
+}
+
+// customResource wraps the spec of a generated workload in a custom resource
+// for operator-driven platforms.
+type customResource struct {
+	unversioned.TypeMeta `json:",inline"`
+	api.ObjectMeta       `json:"metadata"`
+	Spec                 customResourceSpec `json:"spec"`
+}
+
+type customResourceSpec struct {
+	Template interface{} `json:"template"`
+}
+
+// ParseGroupVersionKind parses a Group/Version/Kind string such as
+// platform.example.com/v1/Workload.
+func ParseGroupVersionKind(s string) (unversioned.GroupVersionKind, error) {
+	parts := strings.Split(s, "/")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return unversioned.GroupVersionKind{}, fmt.Errorf("%q is not in the Group/Version/Kind format", s)
+	}
+	return unversioned.GroupVersionKind{Group: parts[0], Version: parts[1], Kind: parts[2]}, nil
+}
+
+// newCustomResource creates a custom resource of kind gvk with the given
+// metadata, placing spec under spec.template.
+func newCustomResource(gvk unversioned.GroupVersionKind, meta api.ObjectMeta, spec interface{}) *customResource {
+	return &customResource{
+		TypeMeta: unversioned.TypeMeta{
+			Kind:       gvk.Kind,
+			APIVersion: gvk.GroupVersion().String(),
+		},
+		ObjectMeta: meta,
+		Spec:       customResourceSpec{Template: spec},
+	}
+}
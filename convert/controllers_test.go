@@ -0,0 +1,455 @@
+/*
+Copyright 2015 Kelsey Hightower All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package convert
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/apis/apps"
+	"k8s.io/kubernetes/pkg/apis/batch"
+	"k8s.io/kubernetes/pkg/apis/extensions"
+	"k8s.io/kubernetes/pkg/util/intstr"
+)
+
+func TestStripLabels(t *testing.T) {
+	const compose = `version: "2"
+services:
+  web:
+    image: nginx
+`
+	serviceLabels := map[string]string{"service": "web"}
+
+	result := convertProject(t, compose, nil, Options{StripLabels: true})
+	rc := findObject(t, result, "ReplicationController", "web").Object.(*api.ReplicationController)
+	if rc.Labels != nil {
+		t.Errorf("got replication controller labels %v, want none", rc.Labels)
+	}
+	if !reflect.DeepEqual(rc.Spec.Selector, serviceLabels) {
+		t.Errorf("got selector %v, want %v", rc.Spec.Selector, serviceLabels)
+	}
+	if !reflect.DeepEqual(rc.Spec.Template.Labels, serviceLabels) {
+		t.Errorf("got pod template labels %v, want %v", rc.Spec.Template.Labels, serviceLabels)
+	}
+
+	result = convertProject(t, compose, nil, Options{StripLabels: true, Controller: ControllerDeployment})
+	deployment := findObject(t, result, "Deployment", "web").Object.(*extensions.Deployment)
+	if deployment.Labels != nil {
+		t.Errorf("got deployment labels %v, want none", deployment.Labels)
+	}
+	if deployment.Spec.Selector == nil || !reflect.DeepEqual(deployment.Spec.Selector.MatchLabels, serviceLabels) {
+		t.Errorf("got selector %v, want %v", deployment.Spec.Selector, serviceLabels)
+	}
+	if !reflect.DeepEqual(deployment.Spec.Template.Labels, serviceLabels) {
+		t.Errorf("got pod template labels %v, want %v", deployment.Spec.Template.Labels, serviceLabels)
+	}
+
+	// Without the flag the controller keeps its labels.
+	result = convertProject(t, compose, nil, Options{})
+	rc = findObject(t, result, "ReplicationController", "web").Object.(*api.ReplicationController)
+	if !reflect.DeepEqual(rc.Labels, serviceLabels) {
+		t.Errorf("got replication controller labels %v, want %v", rc.Labels, serviceLabels)
+	}
+}
+
+func TestDeploymentStrategy(t *testing.T) {
+	two := 2
+	zero := 0
+	tests := []struct {
+		update         updateConfig
+		maxUnavailable intstr.IntOrString
+		maxSurge       intstr.IntOrString
+	}{
+		{updateConfig{}, intstr.FromInt(1), intstr.FromInt(0)},
+		{updateConfig{Order: "stop-first"}, intstr.FromInt(1), intstr.FromInt(0)},
+		{updateConfig{Order: "start-first"}, intstr.FromInt(0), intstr.FromInt(1)},
+		{updateConfig{Parallelism: &two, Order: "stop-first"}, intstr.FromInt(2), intstr.FromInt(0)},
+		{updateConfig{Parallelism: &two, Order: "start-first"}, intstr.FromInt(0), intstr.FromInt(2)},
+		{updateConfig{Parallelism: &zero, Order: "stop-first"}, intstr.FromString("100%"), intstr.FromInt(0)},
+		{updateConfig{Parallelism: &zero, Order: "start-first"}, intstr.FromInt(0), intstr.FromString("100%")},
+	}
+	for _, test := range tests {
+		strategy, err := deploymentStrategy(&test.update)
+		if err != nil {
+			t.Errorf("deploymentStrategy(%s): %v", toJSON(test.update), err)
+			continue
+		}
+		if strategy.Type != extensions.RollingUpdateDeploymentStrategyType {
+			t.Errorf("deploymentStrategy(%s) has type %s, want a rolling update", toJSON(test.update), strategy.Type)
+			continue
+		}
+		rollingUpdate := strategy.RollingUpdate
+		if rollingUpdate.MaxUnavailable != test.maxUnavailable || rollingUpdate.MaxSurge != test.maxSurge {
+			t.Errorf("deploymentStrategy(%s) = maxUnavailable %s, maxSurge %s, want %s, %s", toJSON(test.update),
+				rollingUpdate.MaxUnavailable.String(), rollingUpdate.MaxSurge.String(), test.maxUnavailable.String(), test.maxSurge.String())
+		}
+	}
+
+	minusOne := -1
+	for _, update := range []updateConfig{{Order: "random"}, {Parallelism: &minusOne}} {
+		if _, err := deploymentStrategy(&update); err == nil {
+			t.Errorf("deploymentStrategy(%s) succeeded, want an error", toJSON(update))
+		}
+	}
+}
+
+func TestUpdateConfig(t *testing.T) {
+	result := convertProject(t, `version: "3.4"
+services:
+  web:
+    image: nginx
+    deploy:
+      update_config:
+        parallelism: 3
+        order: start-first
+`, nil, Options{Controller: ControllerDeployment})
+	deployment := findObject(t, result, "Deployment", "web").Object.(*extensions.Deployment)
+	rollingUpdate := deployment.Spec.Strategy.RollingUpdate
+	if rollingUpdate == nil || rollingUpdate.MaxUnavailable != intstr.FromInt(0) || rollingUpdate.MaxSurge != intstr.FromInt(3) {
+		t.Errorf("got strategy %s, want a surge of 3 pods", toJSON(deployment.Spec.Strategy))
+	}
+}
+
+func TestJobRestartPolicy(t *testing.T) {
+	tests := []struct {
+		deploy   string
+		want     api.RestartPolicy
+		warnings int
+	}{
+		{"", api.RestartPolicyOnFailure, 0},
+		{"condition: none", api.RestartPolicyNever, 0},
+		{"condition: on-failure", api.RestartPolicyOnFailure, 0},
+		{"condition: any", api.RestartPolicyOnFailure, 1},
+		{"condition: on-failure\n        max_attempts: 3", api.RestartPolicyOnFailure, 1},
+	}
+	for _, test := range tests {
+		result := convertProject(t, `version: "3"
+services:
+  migrate:
+    image: migrate
+    deploy:
+      restart_policy:
+        `+test.deploy+`
+`, nil, Options{Controller: ControllerJob})
+		job := findObject(t, result, "Job", "migrate").Object.(*batch.Job)
+		if got := job.Spec.Template.Spec.RestartPolicy; got != test.want {
+			t.Errorf("restart policy %q: got %s, want %s", test.deploy, got, test.want)
+		}
+		if got := result.Report.Services["migrate"].Warnings; len(got) != test.warnings {
+			t.Errorf("restart policy %q: got warnings %q, want %d", test.deploy, got, test.warnings)
+		}
+	}
+
+	_, err := Convert(writeProject(t, `version: "3"
+services:
+  migrate:
+    image: migrate
+    deploy:
+      restart_policy:
+        condition: sometimes
+`, nil), Options{Controller: ControllerJob})
+	if err == nil {
+		t.Error("converting an unknown restart condition succeeded, want an error")
+	}
+}
+
+func TestStatefulSet(t *testing.T) {
+	result := convertProject(t, `version: "2"
+services:
+  db:
+    image: postgres
+    ports:
+      - "5432"
+`, nil, Options{Controller: ControllerStatefulSet, NamePrefix: "shop-"})
+
+	statefulSet := findObject(t, result, "StatefulSet", "shop-db").Object.(*apps.StatefulSet)
+	service := findObject(t, result, "Service", "shop-db").Object.(*api.Service)
+	if service.Spec.ClusterIP != api.ClusterIPNone {
+		t.Errorf("got cluster IP %q, want a headless service", service.Spec.ClusterIP)
+	}
+	if !reflect.DeepEqual(service.Spec.Selector, statefulSet.Spec.Selector.MatchLabels) {
+		t.Errorf("got service selector %v, want the stateful set selector %v", service.Spec.Selector, statefulSet.Spec.Selector.MatchLabels)
+	}
+	if len(service.Spec.Ports) != 1 || service.Spec.Ports[0].Port != 5432 {
+		t.Errorf("got service ports %s, want 5432", toJSON(service.Spec.Ports))
+	}
+
+	// The stateful set is governed by the service, and its pods are in the
+	// subdomain of the service.
+	if statefulSet.Spec.ServiceName != service.Name {
+		t.Errorf("got service name %q, want %q", statefulSet.Spec.ServiceName, service.Name)
+	}
+	if got := statefulSet.Spec.Template.Spec.Subdomain; got != service.Name {
+		t.Errorf("got subdomain %q, want %q", got, service.Name)
+	}
+}
+
+func TestStatefulSetNoNetwork(t *testing.T) {
+	composeFile := writeProject(t, `version: "2"
+services:
+  db:
+    image: postgres
+    network_mode: none
+`, nil)
+	_, err := Convert(composeFile, Options{Controller: ControllerStatefulSet})
+	if err == nil || !strings.Contains(err.Error(), "network_mode none") {
+		t.Errorf("got error %v, want one about network_mode none", err)
+	}
+}
+
+func TestInferController(t *testing.T) {
+	tests := []struct {
+		restart        string
+		defaultRestart string
+		kind           string
+		policy         api.RestartPolicy
+	}{
+		{"", "", "Deployment", api.RestartPolicyAlways},
+		{"restart: always", "", "Deployment", api.RestartPolicyAlways},
+		{"restart: unless-stopped", "", "Deployment", api.RestartPolicyAlways},
+		{"restart: \"no\"", "", "Pod", api.RestartPolicyNever},
+		{"restart: on-failure", "", "Job", api.RestartPolicyOnFailure},
+
+		// The default only applies to the services without a restart
+		// option.
+		{"", "no", "Pod", api.RestartPolicyNever},
+		{"", "on-failure", "Job", api.RestartPolicyOnFailure},
+		{"", "always", "Deployment", api.RestartPolicyAlways},
+		{"restart: always", "no", "Deployment", api.RestartPolicyAlways},
+		{"restart: on-failure", "no", "Job", api.RestartPolicyOnFailure},
+	}
+	for _, test := range tests {
+		result := convertProject(t, `version: "2"
+services:
+  task:
+    image: busybox
+    `+test.restart+`
+`, nil, Options{InferController: true, DefaultRestart: test.defaultRestart})
+		if len(result.Objects) != 1 || result.Objects[0].Kind != test.kind {
+			t.Errorf("%q with default %q: got objects %s, want a %s", test.restart, test.defaultRestart, toJSON(result.Objects), test.kind)
+			continue
+		}
+		if got := objectPodSpec(t, result.Objects[0]).RestartPolicy; got != test.policy {
+			t.Errorf("%q with default %q: got restart policy %s, want %s", test.restart, test.defaultRestart, got, test.policy)
+		}
+	}
+
+	// Web apps are converted to a deployment whatever their restart policy.
+	result := convertProject(t, `version: "2"
+services:
+  web:
+    image: nginx
+    restart: "no"
+    ports:
+      - "80"
+    labels:
+      kompose.service.kind: webapp
+`, nil, Options{InferController: true})
+	findObject(t, result, "Deployment", "web")
+}
+
+func TestPodTemplateFragment(t *testing.T) {
+	result := convertProject(t, `version: "2"
+services:
+  web:
+    image: nginx
+    ports:
+      - "80"
+    labels:
+      kompose.service.kind: webapp
+  worker:
+    image: worker
+`, nil, Options{Fragment: FragmentPodTemplate})
+
+	// The controllers are replaced by their pod templates, while the other
+	// objects are kept.
+	var kinds []string
+	for _, obj := range result.Objects {
+		kinds = append(kinds, obj.Kind+"/"+obj.Name)
+	}
+	if want := []string{"Service/web", "PodTemplateSpec/web", "PodTemplateSpec/worker"}; !reflect.DeepEqual(kinds, want) {
+		t.Fatalf("got objects %q, want %q", kinds, want)
+	}
+
+	fragment := findObject(t, result, "PodTemplateSpec", "worker").Object.(*podTemplateFragment)
+	if want := map[string]string{"service": "worker"}; !reflect.DeepEqual(fragment.Labels, want) {
+		t.Errorf("got labels %v, want %v", fragment.Labels, want)
+	}
+	if containers := fragment.Spec.Containers; len(containers) != 1 || containers[0].Image != "worker" {
+		t.Errorf("got containers %s, want the worker image", toJSON(containers))
+	}
+
+	// The fragment is meant to be embedded, so it has no type information.
+	var fields map[string]interface{}
+	if err := json.Unmarshal([]byte(toJSON(fragment)), &fields); err != nil {
+		t.Fatal(err)
+	}
+	for _, field := range []string{"kind", "apiVersion"} {
+		if _, ok := fields[field]; ok {
+			t.Errorf("got field %s in %s, want none", field, toJSON(fragment))
+		}
+	}
+	if _, ok := fields["spec"]; !ok {
+		t.Errorf("got no spec in %s", toJSON(fragment))
+	}
+
+	for _, opts := range []Options{
+		{Fragment: "container"},
+		{Fragment: FragmentPodTemplate, WrapCRD: &unversioned.GroupVersionKind{Group: "example.com", Version: "v1", Kind: "Workload"}},
+	} {
+		if _, err := Convert(writeProject(t, threeServices, nil), opts); err == nil {
+			t.Errorf("converting with fragment %q succeeded, want an error", opts.Fragment)
+		}
+	}
+}
+
+func TestStopGracePeriod(t *testing.T) {
+	const compose = `version: "2"
+services:
+  app:
+    image: app
+    stop_grace_period: 1m30s
+    stop_signal: SIGQUIT
+`
+	for _, controller := range []string{ControllerRC, ControllerPod, ControllerDeployment, ControllerJob, ControllerStatefulSet} {
+		result := convertProject(t, compose, nil, Options{Controller: controller})
+		var obj Object
+		for _, o := range result.Objects {
+			if o.Kind != "Service" {
+				obj = o
+			}
+		}
+		if obj.Object == nil {
+			t.Fatalf("%s: got no controller", controller)
+		}
+
+		spec := objectPodSpec(t, obj)
+		if spec.TerminationGracePeriodSeconds == nil || *spec.TerminationGracePeriodSeconds != 90 {
+			t.Errorf("%s: got grace period %s, want 90 seconds", controller, toJSON(spec.TerminationGracePeriodSeconds))
+		}
+
+		// The stop signal is kept on the pods, which are the object itself
+		// for a bare pod.
+		podAnnotations := annotations(t, obj)
+		switch o := obj.Object.(type) {
+		case *api.ReplicationController:
+			podAnnotations = o.Spec.Template.Annotations
+		case *extensions.Deployment:
+			podAnnotations = o.Spec.Template.Annotations
+		case *batch.Job:
+			podAnnotations = o.Spec.Template.Annotations
+		case *apps.StatefulSet:
+			podAnnotations = o.Spec.Template.Annotations
+		}
+		if got := podAnnotations[stopSignalAnnotation]; got != "SIGQUIT" {
+			t.Errorf("%s: got stop signal %q, want SIGQUIT", controller, got)
+		}
+	}
+
+	for _, period := range []string{"soon", "-5s"} {
+		_, err := Convert(writeProject(t, `version: "2"
+services:
+  app:
+    image: app
+    stop_grace_period: `+period+`
+`, nil), Options{})
+		if err == nil {
+			t.Errorf("converting stop_grace_period %s succeeded, want an error", period)
+		}
+	}
+}
+
+func TestProgressDeadline(t *testing.T) {
+	result := convertProject(t, `version: "2"
+services:
+  web:
+    image: nginx
+    labels:
+      kompose.deployment.progressDeadline: "600"
+  worker:
+    image: worker
+`, nil, Options{Controller: ControllerDeployment})
+
+	web := findObject(t, result, "Deployment", "web").Object.(*extensions.Deployment)
+	if got := web.Spec.ProgressDeadlineSeconds; got == nil || *got != 600 {
+		t.Errorf("got progress deadline %s, want 600 seconds", toJSON(got))
+	}
+	worker := findObject(t, result, "Deployment", "worker").Object.(*extensions.Deployment)
+	if got := worker.Spec.ProgressDeadlineSeconds; got != nil {
+		t.Errorf("got progress deadline %d without the label, want none", *got)
+	}
+
+	for _, value := range []string{"0", "-1", "10m"} {
+		_, err := Convert(writeProject(t, `version: "2"
+services:
+  web:
+    image: nginx
+    labels:
+      kompose.deployment.progressDeadline: "`+value+`"
+`, nil), Options{Controller: ControllerDeployment})
+		if err == nil {
+			t.Errorf("converting progress deadline %q succeeded, want an error", value)
+		}
+	}
+}
+
+func TestRevisionHistoryLimit(t *testing.T) {
+	const compose = `version: "2"
+services:
+  web:
+    image: nginx
+    labels:
+      kompose.deployment.revisionHistoryLimit: "0"
+  worker:
+    image: worker
+`
+	five := int32(5)
+	tests := []struct {
+		opts   Options
+		web    int32
+		worker *int32
+	}{
+		// The label wins over the option, which applies to the others.
+		{Options{Controller: ControllerDeployment}, 0, nil},
+		{Options{Controller: ControllerDeployment, RevisionHistoryLimit: &five}, 0, &five},
+	}
+	for _, test := range tests {
+		result := convertProject(t, compose, nil, test.opts)
+		web := findObject(t, result, "Deployment", "web").Object.(*extensions.Deployment)
+		if got := web.Spec.RevisionHistoryLimit; got == nil || *got != test.web {
+			t.Errorf("limit %s: got web limit %s, want %d", toJSON(test.opts.RevisionHistoryLimit), toJSON(got), test.web)
+		}
+		worker := findObject(t, result, "Deployment", "worker").Object.(*extensions.Deployment)
+		if got := worker.Spec.RevisionHistoryLimit; !reflect.DeepEqual(got, test.worker) {
+			t.Errorf("limit %s: got worker limit %s, want %s", toJSON(test.opts.RevisionHistoryLimit), toJSON(got), toJSON(test.worker))
+		}
+	}
+
+	for _, value := range []string{"-1", "all"} {
+		_, err := Convert(writeProject(t, `version: "2"
+services:
+  web:
+    image: nginx
+    labels:
+      kompose.deployment.revisionHistoryLimit: "`+value+`"
+`, nil), Options{Controller: ControllerDeployment})
+		if err == nil {
+			t.Errorf("converting revision history limit %q succeeded, want an error", value)
+		}
+	}
+}
@@ -0,0 +1,1579 @@
+/*
+Copyright 2015 Kelsey Hightower All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package convert converts docker-compose projects to Kubernetes objects.
+package convert
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/docker/libcompose/config"
+	"github.com/docker/libcompose/project"
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/util/intstr"
+	"k8s.io/kubernetes/pkg/util/validation"
+)
+
+// Annotations used to preserve compose settings that have no Kubernetes
+// equivalent.
+const (
+	cgroupParentAnnotation   = "compose2kube.io/cgroup-parent"
+	composeVersionAnnotation = "compose2kube.io/compose-version"
+	hostIPAnnotationPrefix   = "compose2kube.io/host-ip-"
+	initAnnotation           = "compose2kube.io/init"
+	macAddressAnnotation     = "compose2kube.io/mac-address"
+	oomKillDisableAnnotation = "compose2kube.io/oom-kill-disable"
+	oomScoreAdjAnnotation    = "compose2kube.io/oom-score-adj"
+	runtimeAnnotation        = "compose2kube.io/runtime"
+	stopSignalAnnotation     = "compose2kube.io/stop-signal"
+	ulimitAnnotationPrefix   = "compose2kube.io/ulimit-"
+)
+
+// cgroupPath matches cgroup paths such as /docker or user.slice, made up of
+// one or more names separated by slashes.
+var cgroupPath = regexp.MustCompile(`^/?[A-Za-z0-9_.@:-]+(/[A-Za-z0-9_.@:-]+)*/?$`)
+
+// Options configures a conversion.
+type Options struct {
+	// Controller is the kind of controller created for each service, one
+	// of the Controller constants. It defaults to ControllerRC.
+	Controller string
+	// RevisionHistoryLimit is the number of old replica sets kept by each
+	// deployment, unless its service sets the
+	// kompose.deployment.revisionHistoryLimit label. Kubernetes applies its
+	// default when nil.
+	RevisionHistoryLimit *int32
+	// WrapCRD wraps each workload in a custom resource of the given kind.
+	WrapCRD *unversioned.GroupVersionKind
+	// Fragment, when set, replaces the controller of each service with
+	// just a part of it. FragmentPodTemplate is the only supported part.
+	Fragment string
+	// AllowUnset substitutes an empty string for unset variables without a
+	// default, instead of failing.
+	AllowUnset bool
+	// InjectTini runs the command of services with init: true under tini.
+	InjectTini bool
+	// EnvFirstWins reverses the precedence of environment variables, so
+	// that earlier env files override later ones and env files override
+	// environment. By default the last value wins, like docker-compose.
+	EnvFirstWins bool
+	// EmitLinkEnv adds the legacy Docker link environment variables for each
+	// dependency of a service.
+	EmitLinkEnv bool
+	// StripLabels omits the tool-managed labels from controller metadata.
+	StripLabels bool
+	// DefaultResources are the requests and limits of the resources a
+	// service sets neither a request nor a limit for.
+	DefaultResources api.ResourceRequirements
+	// LimitRange adds a limit range to the namespace, defaulting the
+	// resources of its containers to DefaultResources and bounding them by
+	// MaxResources.
+	LimitRange bool
+	// MaxResources are the maximum resources of a container in the limit
+	// range.
+	MaxResources api.ResourceList
+	// Quota adds a resource quota to the namespace with these hard limits
+	// when it is not empty.
+	Quota api.ResourceList
+	// ConfigMapFiles replaces read-only bind mounts of single files with a
+	// ConfigMap holding the file.
+	ConfigMapFiles bool
+	// AutoProbe adds a TCP readiness probe to services exposing a single
+	// port.
+	AutoProbe bool
+	// Strict fails the conversion when anything cannot be translated
+	// faithfully, which otherwise only causes a warning.
+	Strict bool
+	// ColocateDependencies prefers scheduling the pods of a service on the
+	// nodes running the pods of its dependencies.
+	ColocateDependencies bool
+	// Profiles enables the services with any of the named profiles, on top
+	// of the services without profiles, which are always enabled.
+	Profiles []string
+	// NamePrefix is prepended to the names of the generated objects and to
+	// the service label values selecting their pods, so that several
+	// compose projects can share a namespace.
+	NamePrefix string
+	// DefaultDeny adds a network policy denying all ingress traffic in the
+	// namespace, and a policy for each compose network allowing the traffic
+	// between the services attached to it. The namespace must be annotated
+	// to isolate its pods for the policies to take effect.
+	DefaultDeny bool
+	// WaitForDependencies adds an init container to the pods of a service
+	// for each of its depends_on services, waiting until the dependency
+	// meets its condition.
+	WaitForDependencies bool
+	// InferController picks the controller of each service from its restart
+	// policy instead of using Controller.
+	InferController bool
+	// DefaultRestart is the restart policy of the services without one:
+	// always, no or on-failure. It defaults to always.
+	DefaultRestart string
+	// EnvFromFiles reads the environment values of the form ${file:path}
+	// from the file at path, and passes them on as set by EnvFromFilesInline
+	// or EnvFromFilesSecret. Without it such references are invalid.
+	EnvFromFiles string
+	// Only restricts the conversion to the named services among the
+	// enabled ones.
+	Only []string
+	// Skip excludes the named services from the conversion. It cannot be
+	// combined with Only.
+	Skip []string
+	// APIVersions overrides the API version of the objects of the given
+	// kinds, which must be among EmittedKinds.
+	APIVersions map[string]string
+	// Mutators are run over every generated object, in order.
+	Mutators []Mutator
+}
+
+// EmittedKinds lists the kinds of the objects Convert can create, besides
+// custom resources.
+var EmittedKinds = []string{
+	"ConfigMap",
+	"Deployment",
+	"Endpoints",
+	"Ingress",
+	"Job",
+	"LimitRange",
+	"NetworkPolicy",
+	"PersistentVolumeClaim",
+	"Pod",
+	"ReplicationController",
+	"ResourceQuota",
+	"Secret",
+	"Service",
+	"StatefulSet",
+}
+
+// Object is a Kubernetes object generated from a compose file.
+type Object struct {
+	runtime.Object
+	// Service is the compose service the object was generated for. It is
+	// empty for objects shared by the project, such as secrets.
+	Service string
+	// Kind and Name identify the object.
+	Kind string
+	Name string
+	// BaseName is the suggested name of the file to save the object to,
+	// without an extension.
+	BaseName string
+}
+
+// Build describes a service image that is built from source.
+type Build struct {
+	Image      string
+	Context    string
+	Dockerfile string
+}
+
+// Result holds the outcome of a conversion.
+type Result struct {
+	// Objects are the generated objects in creation order.
+	Objects []Object
+	// Builds lists the services that build their image.
+	Builds []Build
+	// Report summarises the conversion.
+	Report *Report
+}
+
+// converter holds the state of a single conversion.
+type converter struct {
+	opts        Options
+	composeFile string
+	lookupEnv   func(string) (string, bool)
+	scope       map[string]string
+	report      *Report
+	configs     *config.ServiceConfigs
+	extras      *composeExtras
+	deps        map[string][]string
+	order       map[string]int
+	fileRefs    bool
+	// waited holds the services the init containers of other services wait
+	// for, which need a service resolving their name.
+	waited map[string]bool
+}
+
+// Convert converts the compose file at composeFile to Kubernetes objects.
+// When composeFile is a directory, the standard compose file in it is
+// converted together with its override file, like docker-compose does.
+func Convert(composeFile string, opts Options) (*Result, error) {
+	if opts.Controller == "" {
+		opts.Controller = ControllerRC
+	}
+	switch opts.Controller {
+	case ControllerRC, ControllerPod, ControllerDeployment, ControllerJob, ControllerStatefulSet:
+	default:
+		return nil, fmt.Errorf("unknown controller %s, must be one of rc, pod, deployment, job or statefulset", opts.Controller)
+	}
+
+	switch opts.DefaultRestart {
+	case "", "always", "no", "on-failure":
+	default:
+		return nil, fmt.Errorf("unknown default restart policy %s, must be one of always, no or on-failure", opts.DefaultRestart)
+	}
+
+	switch opts.EnvFromFiles {
+	case "", EnvFromFilesInline, EnvFromFilesSecret:
+	default:
+		return nil, fmt.Errorf("unknown env from files mode %s, must be inline or secret", opts.EnvFromFiles)
+	}
+
+	if opts.NamePrefix != "" {
+		if errs := validation.IsDNS1123Label(opts.NamePrefix + "x"); len(errs) > 0 {
+			return nil, fmt.Errorf("invalid name prefix %s: %s", opts.NamePrefix, strings.Join(errs, ", "))
+		}
+	}
+
+	switch opts.Fragment {
+	case "":
+	case FragmentPodTemplate:
+		if opts.WrapCRD != nil {
+			return nil, fmt.Errorf("fragments cannot be wrapped in a custom resource")
+		}
+	default:
+		return nil, fmt.Errorf("unknown fragment %s, must be podtemplate", opts.Fragment)
+	}
+	for kind, version := range opts.APIVersions {
+		if !emitted(kind) {
+			return nil, fmt.Errorf("cannot override the API version of %s, must be one of %s", kind, strings.Join(EmittedKinds, ", "))
+		}
+		if version == "" {
+			return nil, fmt.Errorf("empty API version for %s", kind)
+		}
+	}
+	if len(opts.Only) > 0 && len(opts.Skip) > 0 {
+		return nil, fmt.Errorf("only and skip cannot be used together")
+	}
+
+	files, err := composeFiles(composeFile)
+	if err != nil {
+		return nil, err
+	}
+	composeFile = files[0]
+
+	c := &converter{
+		opts:        opts,
+		composeFile: composeFile,
+		lookupEnv:   os.LookupEnv,
+		report:      newReport(),
+	}
+
+	composeBytes, extras, err := c.loadCompose(files)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load the compose file %s: %v", composeFile, err)
+	}
+	c.extras = extras
+
+	p := project.NewProject(&project.Context{
+		ProjectName:  "kube",
+		ComposeFiles: []string{composeFile},
+		ComposeBytes: [][]byte{composeBytes},
+	}, nil, nil)
+
+	if err := p.Parse(); err != nil {
+		return nil, fmt.Errorf("failed to parse the compose project from %s: %v%s", composeFile, err, versionHint(err, extras.Version))
+	}
+	if p.ServiceConfigs == nil {
+		return nil, fmt.Errorf("no service config found")
+	}
+	c.configs = p.ServiceConfigs
+	// The services are converted in name order, as libcompose does not keep
+	// the order they are declared in.
+	keys := p.ServiceConfigs.Keys()
+	sort.Strings(keys)
+
+	// Work out the apply order of the services from their dependencies.
+	deps := make(map[string][]string, len(keys))
+	for _, name := range keys {
+		service, ok := p.ServiceConfigs.Get(name)
+		if !ok {
+			return nil, fmt.Errorf("failed to get key %s from config", name)
+		}
+		deps[name] = service.DependsOn
+	}
+	c.deps = deps
+	c.order, err = dependencyOrder(deps)
+	if err != nil {
+		return nil, fmt.Errorf("failed to order the compose services: %v", err)
+	}
+
+	selected, err := c.selectServices(keys)
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.WaitForDependencies {
+		c.waited = make(map[string]bool)
+		for _, name := range selected {
+			for _, dep := range deps[name] {
+				if extras.Services[name].DependsOn[dep].Condition != conditionCompleted {
+					c.waited[dep] = true
+				}
+			}
+		}
+	}
+
+	result := &Result{Report: c.report}
+
+	// Create the secrets declared in the compose file. External secrets must
+	// already exist in the cluster.
+	for _, name := range sortedKeys(extras.Secrets) {
+		config := extras.Secrets[name]
+		if config.External {
+			continue
+		}
+		secret, err := newSecret(name, config, filepath.Dir(composeFile))
+		if err != nil {
+			return nil, fmt.Errorf("failed to create secret %s: %v", name, err)
+		}
+		secret.Name = c.fileObjectName(name, config)
+		result.Objects = append(result.Objects, Object{
+			Object:   secret,
+			Kind:     secret.Kind,
+			Name:     secret.Name,
+			BaseName: secret.Name + "-secret",
+		})
+	}
+
+	// Create the configs declared in the compose file. External configs must
+	// already exist in the cluster.
+	for _, name := range sortedKeys(extras.Configs) {
+		config := extras.Configs[name]
+		if config.External {
+			continue
+		}
+		configMap, err := newConfigMap(name, config, filepath.Dir(composeFile))
+		if err != nil {
+			return nil, fmt.Errorf("failed to create config %s: %v", name, err)
+		}
+		configMap.Name = c.fileObjectName(name, config)
+		result.Objects = append(result.Objects, Object{
+			Object:   configMap,
+			Kind:     configMap.Kind,
+			Name:     configMap.Name,
+			BaseName: configMap.Name + "-configmap",
+		})
+	}
+
+	for _, name := range selected {
+		service, _ := p.ServiceConfigs.Get(name)
+		objects, err := c.convertService(name, service)
+		c.report.flushSkipped(name)
+		if err != nil {
+			return nil, err
+		}
+		result.Objects = append(result.Objects, objects...)
+
+		// Only services with a build section have images that can be built.
+		if service.Build.Context != "" {
+			build, err := c.build(name, service)
+			if err != nil {
+				return nil, err
+			}
+			result.Builds = append(result.Builds, build)
+		}
+	}
+
+	// Create the claims backing the named volumes of the converted
+	// services, once for each volume.
+	claims := make(map[string]bool)
+	for _, name := range selected {
+		service, _ := p.ServiceConfigs.Get(name)
+		_, volumes := serviceVolumes(service, extras.Services[name])
+		for _, volume := range volumes {
+			if volume.Type == "volume" && volume.Source != "" {
+				claims[volume.Source] = true
+			}
+		}
+	}
+	volumeNames := make([]string, 0, len(claims))
+	for volume := range claims {
+		volumeNames = append(volumeNames, volume)
+	}
+	sort.Strings(volumeNames)
+	for _, volume := range volumeNames {
+		claim := newClaim(c.objectName(claimName(volume)))
+		result.Objects = append(result.Objects, Object{
+			Object:   claim,
+			Kind:     claim.Kind,
+			Name:     claim.Name,
+			BaseName: claim.Name + "-pvc",
+		})
+	}
+
+	if opts.LimitRange {
+		limitRange, err := newLimitRange(c.objectName("limits"), opts.DefaultResources, opts.MaxResources)
+		if err != nil {
+			return nil, fmt.Errorf("invalid limit range: %v", err)
+		}
+		result.Objects = append(result.Objects, Object{
+			Object:   limitRange,
+			Kind:     limitRange.Kind,
+			Name:     limitRange.Name,
+			BaseName: limitRange.Name + "-limitrange",
+		})
+	}
+
+	if len(opts.Quota) > 0 {
+		quota, err := newResourceQuota(c.objectName("quota"), opts.Quota)
+		if err != nil {
+			return nil, fmt.Errorf("invalid resource quota: %v", err)
+		}
+		result.Objects = append(result.Objects, Object{
+			Object:   quota,
+			Kind:     quota.Kind,
+			Name:     quota.Name,
+			BaseName: quota.Name + "-quota",
+		})
+	}
+
+	// With the namespace denying all ingress traffic, allow the traffic
+	// within each network of the converted services.
+	if opts.DefaultDeny {
+		deny := newDenyPolicy(c.objectName("default-deny"))
+		result.Objects = append(result.Objects, Object{
+			Object:   deny,
+			Kind:     deny.Kind,
+			Name:     deny.Name,
+			BaseName: deny.Name + "-netpol",
+		})
+		networks := make(map[string]bool)
+		for _, name := range selected {
+			service, _ := p.ServiceConfigs.Get(name)
+			for _, network := range serviceNetworks(service) {
+				networks[network] = true
+			}
+		}
+		networkNames := make([]string, 0, len(networks))
+		for network := range networks {
+			networkNames = append(networkNames, network)
+		}
+		sort.Strings(networkNames)
+		for _, network := range networkNames {
+			networkName, err := c.networkName(network)
+			if err != nil {
+				return nil, err
+			}
+			policy := newNetworkPolicy(networkName)
+			result.Objects = append(result.Objects, Object{
+				Object:   policy,
+				Kind:     policy.Kind,
+				Name:     policy.Name,
+				BaseName: policy.Name + "-netpol",
+			})
+		}
+	}
+
+	// Record the compose format every object was generated from.
+	version := AnnotationMutator{Annotations: map[string]string{composeVersionAnnotation: extras.Version}}
+	// Roll the pods out again when the config maps or secrets they mount
+	// change.
+	mutators := []Mutator{version, newConfigChecksumMutator(result.Objects)}
+	if len(opts.APIVersions) > 0 {
+		mutators = append(mutators, apiVersionMutator{versions: opts.APIVersions})
+	}
+	mutators = append(mutators, opts.Mutators...)
+	for _, obj := range result.Objects {
+		// Every object of a service is applied in the position of the
+		// service and records its dependencies. Objects shared by the
+		// services come first.
+		order := AnnotationMutator{Annotations: map[string]string{orderAnnotation: strconv.Itoa(c.order[obj.Service])}}
+		if deps := c.deps[obj.Service]; len(deps) > 0 {
+			order.Annotations[dependsOnAnnotation] = strings.Join(deps, ",")
+		}
+		if err := order.Mutate(obj.Object); err != nil {
+			return nil, fmt.Errorf("failed to annotate %s %s: %v", obj.Kind, obj.Name, err)
+		}
+		for _, m := range mutators {
+			if err := m.Mutate(obj.Object); err != nil {
+				return nil, fmt.Errorf("failed to mutate %s %s: %v", obj.Kind, obj.Name, err)
+			}
+		}
+		c.report.addObject(obj.Service, obj.Kind, obj.Name)
+	}
+
+	if opts.Strict {
+		if warnings := c.report.allWarnings(); len(warnings) > 0 {
+			return nil, fmt.Errorf("%d warnings in strict mode: %s", len(warnings), strings.Join(warnings, "; "))
+		}
+	}
+	return result, nil
+}
+
+// convertService converts a single compose service.
+func (c *converter) convertService(name string, service *config.ServiceConfig) ([]Object, error) {
+	extras := c.extras.Services[name]
+	objectName := c.objectName(name)
+
+	rc := &api.ReplicationController{
+		TypeMeta: unversioned.TypeMeta{
+			Kind:       "ReplicationController",
+			APIVersion: "v1",
+		},
+		ObjectMeta: api.ObjectMeta{
+			Name:        objectName,
+			Labels:      map[string]string{"service": objectName},
+			Annotations: map[string]string{},
+		},
+		Spec: api.ReplicationControllerSpec{
+			Replicas: 1,
+			Selector: map[string]string{"service": objectName},
+			Template: &api.PodTemplateSpec{
+				ObjectMeta: api.ObjectMeta{
+					Labels:      map[string]string{"service": objectName},
+					Annotations: map[string]string{},
+				},
+				Spec: api.PodSpec{
+					Containers: []api.Container{
+						{
+							Name:       name,
+							Image:      service.Image,
+							Command:    containerCommand(service.Command, extras.ShellCommand),
+							WorkingDir: service.WorkingDir,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	// An entrypoint replaces the one of the image, and the command, split
+	// into words, becomes its arguments.
+	if entrypoint := containerCommand(service.Entrypoint, ""); entrypoint != nil {
+		container := &rc.Spec.Template.Spec.Containers[0]
+		container.Command = entrypoint
+		container.Args = containerCommand(service.Command, "")
+	}
+
+	// Compose labels are carried over to the pods, as annotations when their
+	// value cannot be a label value. The service label stays load-bearing.
+	labels, annotations, demoted, invalid := splitLabels(service.Labels)
+	for _, label := range invalid {
+		c.report.skipf(name, "labels", "Ignoring label %s of service %s, it is not a valid key: %s", label.Key, name, strings.Join(label.Errors, "; "))
+	}
+	for _, label := range demoted {
+		c.report.serviceWarnf(name, "Label %s of service %s is kept as an annotation, its value is not a valid label value: %s", label.Key, name, strings.Join(label.Errors, "; "))
+	}
+	template := &rc.Spec.Template.ObjectMeta
+	for key, value := range labels {
+		if key == "service" {
+			c.report.skipf(name, "labels", "Ignoring label service of service %s, it is set by compose2kube", name)
+			continue
+		}
+		template.Labels[key] = value
+	}
+	for key, value := range annotations {
+		template.Annotations[key] = value
+	}
+
+	// Configure the number of replicas.
+	if deploy := extras.Deploy; deploy != nil && deploy.Replicas != nil {
+		if *deploy.Replicas < 0 {
+			return nil, fmt.Errorf("invalid replicas %d for service %s", *deploy.Replicas, name)
+		}
+		rc.Spec.Replicas = *deploy.Replicas
+	}
+
+	// Configure the container ports.
+	var ports []api.ContainerPort
+	for _, port := range service.Ports {
+		hostIP, portNumber, err := parsePort(port)
+		if err != nil {
+			return nil, fmt.Errorf("invalid container port %s for service %s", port, name)
+		}
+		ports = append(ports, api.ContainerPort{ContainerPort: portNumber})
+		// Services cannot be bound to a host interface, so the address is
+		// only preserved as an annotation.
+		if hostIP != "" {
+			rc.Spec.Template.Annotations[fmt.Sprintf("%s%d", hostIPAnnotationPrefix, portNumber)] = hostIP
+		}
+	}
+	rc.Spec.Template.Spec.Containers[0].Ports = ports
+
+	// Kubernetes cannot take the network away from a pod, so a service
+	// without networking still gets one, but no service routes to it.
+	noNetwork := service.NetworkMode == "none"
+	if noNetwork {
+		c.report.skipf(name, "network_mode", "Ignoring network_mode none for service %s, pods always have a network, but no service is created for it", name)
+	}
+
+	// External dependencies only get a service pointing at their addresses,
+	// as nothing runs in the cluster for them.
+	if ips, ok := service.Labels[externalIPLabel]; ok {
+		if kind := service.Labels[serviceKindLabel]; kind != "" {
+			return nil, fmt.Errorf("the %s label cannot be combined with %s %s for service %s", externalIPLabel, serviceKindLabel, kind, name)
+		}
+		if noNetwork {
+			return nil, fmt.Errorf("the %s label cannot be combined with network_mode none for service %s", externalIPLabel, name)
+		}
+		objects, err := newExternalService(name, rc, ips)
+		if err != nil {
+			return nil, fmt.Errorf("invalid external service %s: %v", name, err)
+		}
+		return objects, nil
+	}
+
+	// Translate the healthcheck into a liveness probe, as Docker stops
+	// unhealthy containers of swarm services. The kompose.liveness and
+	// kompose.readiness labels configure each probe explicitly, overriding
+	// the healthcheck.
+	if extras.Healthcheck != nil {
+		probe, err := healthcheckProbe(extras.Healthcheck)
+		if err != nil {
+			return nil, fmt.Errorf("invalid healthcheck for service %s: %v", name, err)
+		}
+		rc.Spec.Template.Spec.Containers[0].LivenessProbe = probe
+	}
+	labelProbes := []struct {
+		kind   string
+		prefix string
+		probe  **api.Probe
+	}{
+		{"liveness", livenessLabelPrefix, &rc.Spec.Template.Spec.Containers[0].LivenessProbe},
+		{"readiness", readinessLabelPrefix, &rc.Spec.Template.Spec.Containers[0].ReadinessProbe},
+	}
+	for _, p := range labelProbes {
+		probe, err := labelProbe(service.Labels, p.prefix)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s probe for service %s: %v", p.kind, name, err)
+		}
+		if probe != nil {
+			*p.probe = probe
+		}
+	}
+
+	// Probe the port of services exposing exactly one, unless the service
+	// configures its readiness probe itself. With more ports it is unknown
+	// which one signals readiness.
+	if c.opts.AutoProbe && len(ports) == 1 && rc.Spec.Template.Spec.Containers[0].ReadinessProbe == nil {
+		rc.Spec.Template.Spec.Containers[0].ReadinessProbe = &api.Probe{
+			Handler: api.Handler{
+				TCPSocket: &api.TCPSocketAction{Port: intstr.FromInt(int(ports[0].ContainerPort))},
+			},
+			InitialDelaySeconds: 5,
+			TimeoutSeconds:      1,
+			PeriodSeconds:       10,
+		}
+	}
+
+	// Configure the container ENV variables. Variables from later env files
+	// override earlier ones, and environment overrides them all, unless
+	// EnvFirstWins reverses the precedence.
+	replace := !c.opts.EnvFirstWins
+	var envs []api.EnvVar
+	for _, path := range extras.EnvFile {
+		fileEnvs, err := c.readEnvFile(path, filepath.Dir(c.composeFile))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read env_file for service %s: %v", name, err)
+		}
+		for _, env := range fileEnvs {
+			var duplicate bool
+			if envs, duplicate = setEnv(envs, env, replace); duplicate {
+				if replace {
+					c.report.serviceWarnf(name, "Variable %s of service %s is set more than once in env_file, using the value from %s", env.Name, name, path)
+				} else {
+					c.report.serviceWarnf(name, "Variable %s of service %s is set more than once in env_file, ignoring the value from %s", env.Name, name, path)
+				}
+			}
+		}
+	}
+	for _, env := range service.Environment {
+		if strings.Contains(env, "=") {
+			parts := strings.SplitN(env, "=", 2)
+			ename := parts[0]
+			evalue := parts[1]
+			var duplicate bool
+			if envs, duplicate = setEnv(envs, api.EnvVar{Name: ename, Value: evalue}, replace); duplicate {
+				if replace {
+					c.report.serviceWarnf(name, "Variable %s of service %s is set more than once, using the value from environment", ename, name)
+				} else {
+					c.report.serviceWarnf(name, "Variable %s of service %s is set more than once, using the value from env_file", ename, name)
+				}
+			}
+		}
+	}
+	for _, env := range envs {
+		if errs := validation.IsCIdentifier(env.Name); len(errs) > 0 {
+			return nil, fmt.Errorf("invalid environment variable name %s for service %s: %s", env.Name, name, strings.Join(errs, ", "))
+		}
+	}
+	// Read the values referencing files, either inlining them or moving them
+	// to a secret of the service.
+	var envSecret *api.Secret
+	if c.opts.EnvFromFiles != "" {
+		secretName := objectName + "-env"
+		secretData := make(map[string][]byte)
+		for i, env := range envs {
+			path, ok, err := fileEnvPath(env.Value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid environment variable %s for service %s: %v", env.Name, name, err)
+			}
+			if !ok {
+				continue
+			}
+			value, err := readEnvFromFile(path, filepath.Dir(c.composeFile))
+			if err != nil {
+				return nil, fmt.Errorf("failed to read environment variable %s for service %s: %v", env.Name, name, err)
+			}
+			if c.opts.EnvFromFiles == EnvFromFilesInline {
+				envs[i].Value = value
+				continue
+			}
+			secretData[env.Name] = []byte(value)
+			envs[i].Value = ""
+			envs[i].ValueFrom = &api.EnvVarSource{
+				SecretKeyRef: &api.SecretKeySelector{
+					LocalObjectReference: api.LocalObjectReference{Name: secretName},
+					Key:                  env.Name,
+				},
+			}
+		}
+		if len(secretData) > 0 {
+			envSecret = newEnvSecret(secretName, secretData)
+		}
+	}
+	if c.opts.EmitLinkEnv {
+		links, err := linkEnvs(c.configs, name, c.opts.NamePrefix, envs)
+		if err != nil {
+			return nil, err
+		}
+		envs = append(envs, links...)
+	}
+	// External links point at containers Kubernetes knows nothing about, so
+	// only their names are passed on.
+	for _, link := range service.ExternalLinks {
+		c.report.serviceWarnf(name, "External link %s of service %s only resolves with a Service and Endpoints set up for it by hand", link, name)
+	}
+	envs = append(envs, externalLinkEnvs(service.ExternalLinks, envs)...)
+	rc.Spec.Template.Spec.Containers[0].Env = envs
+
+	// Configure the volumes. Bind mounts in the long syntax are handled
+	// like those in the short syntax.
+	var objects []Object
+	if envSecret != nil {
+		objects = append(objects, Object{
+			Object:   envSecret,
+			Service:  name,
+			Kind:     envSecret.Kind,
+			Name:     envSecret.Name,
+			BaseName: envSecret.Name + "-secret",
+		})
+	}
+	var volumemounts []api.VolumeMount
+	var volumes []api.Volume
+	volumeSpecs, longVolumes := serviceVolumes(service, extras)
+	mountedHostPaths := make(map[string]bool)
+	for _, volumestr := range volumeSpecs {
+		parts := strings.SplitN(volumestr, ":", 3)
+		if len(parts) < 2 || parts[0] == "" || parts[1] == "" {
+			return nil, fmt.Errorf("invalid bind mount %s for service %s, must be source:target", volumestr, name)
+		}
+		partHostDir, err := resolveHostPath(parts[0], filepath.Dir(c.composeFile))
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve host path %s for service %s: %v", parts[0], name, err)
+		}
+		partContainerDir := parts[1]
+		partReadOnly := len(parts) > 2 && readOnlyMode(parts[2])
+		if c.opts.ConfigMapFiles && partReadOnly {
+			configMap, key, err := newFileConfigMap(objectName, partHostDir, len(objects))
+			if err != nil {
+				return nil, fmt.Errorf("failed to read %s for service %s: %v", partHostDir, name, err)
+			}
+			if configMap != nil {
+				objects = append(objects, Object{
+					Object:   configMap,
+					Service:  name,
+					Kind:     configMap.Kind,
+					Name:     configMap.Name,
+					BaseName: configMap.Name + "-configmap",
+				})
+				source := api.VolumeSource{
+					ConfigMap: &api.ConfigMapVolumeSource{
+						LocalObjectReference: api.LocalObjectReference{Name: configMap.Name},
+					},
+				}
+				volumes = append(volumes, api.Volume{Name: configMap.Name, VolumeSource: source})
+				volumemounts = append(volumemounts, api.VolumeMount{Name: configMap.Name, ReadOnly: true, MountPath: partContainerDir, SubPath: key})
+				continue
+			}
+		}
+		// A host path mounted at several targets is a single volume.
+		partName := hostPathVolumeName(partHostDir)
+		volumemounts = append(volumemounts, api.VolumeMount{Name: partName, ReadOnly: partReadOnly, MountPath: partContainerDir})
+		if !mountedHostPaths[partHostDir] {
+			// Docker creates a missing host path as a directory. This API
+			// version has no host path types, so the kubelet cannot be
+			// asked to. Only the relative paths are expected to exist
+			// where the conversion runs, the others are paths of the
+			// nodes.
+			relative := strings.HasPrefix(parts[0], ".")
+			if _, err := os.Stat(partHostDir); relative && os.IsNotExist(err) {
+				c.report.serviceWarnf(name, "Host path %s of service %s does not exist, it must be created on the nodes as the kubelet does not create missing host paths in this API version", partHostDir, name)
+			}
+			source := &api.HostPathVolumeSource{
+				Path: partHostDir,
+			}
+			vsource := api.VolumeSource{HostPath: source}
+			volumes = append(volumes, api.Volume{Name: partName, VolumeSource: vsource})
+			mountedHostPaths[partHostDir] = true
+		}
+	}
+	// A claim mounted at several targets is a single volume, read only
+	// unless one of the mounts is writable.
+	claimVolumes := make(map[string]*api.PersistentVolumeClaimVolumeSource)
+	for i, volume := range longVolumes {
+		if volume.Target == "" {
+			return nil, fmt.Errorf("missing target for %s volume of service %s", volume.Type, name)
+		}
+		var source api.VolumeSource
+		var volumeName string
+		switch volume.Type {
+		case "volume":
+			// Like Docker's, an anonymous volume lives as long as the pod.
+			if volume.Source == "" {
+				volumeName = fmt.Sprintf("anonymous-%d", i)
+				source.EmptyDir = &api.EmptyDirVolumeSource{}
+				break
+			}
+			volumeName = claimName(volume.Source)
+			if claim, ok := claimVolumes[volumeName]; ok {
+				claim.ReadOnly = claim.ReadOnly && volume.ReadOnly
+				volumemounts = append(volumemounts, api.VolumeMount{Name: volumeName, ReadOnly: volume.ReadOnly, MountPath: volume.Target})
+				continue
+			}
+			source.PersistentVolumeClaim = &api.PersistentVolumeClaimVolumeSource{ClaimName: c.objectName(volumeName), ReadOnly: volume.ReadOnly}
+			claimVolumes[volumeName] = source.PersistentVolumeClaim
+		case "tmpfs":
+			volumeName = fmt.Sprintf("tmpfs-%d", i)
+			source.EmptyDir = &api.EmptyDirVolumeSource{Medium: api.StorageMediumMemory}
+			if volume.Tmpfs != nil && volume.Tmpfs.Size != nil {
+				c.report.skipf(name, "volumes.tmpfs.size", "Ignoring the size of tmpfs volume %s of service %s, memory-backed volumes cannot be limited in this API version", volume.Target, name)
+			}
+		default:
+			return nil, fmt.Errorf("unknown volume type %q for service %s", volume.Type, name)
+		}
+		volumes = append(volumes, api.Volume{Name: volumeName, VolumeSource: source})
+		volumemounts = append(volumemounts, api.VolumeMount{Name: volumeName, ReadOnly: volume.ReadOnly, MountPath: volume.Target})
+	}
+	// The tmpfs option takes a path, optionally followed by mount options
+	// such as /run:size=64m,noexec. The API version used here cannot limit
+	// the size of memory-backed volumes, so the size and mode are only
+	// validated.
+	for i, spec := range service.Tmpfs {
+		parts := strings.SplitN(spec, ":", 2)
+		volumeName := fmt.Sprintf("tmpfs-%d", len(longVolumes)+i)
+		mount := api.VolumeMount{Name: volumeName, MountPath: parts[0]}
+		if len(parts) == 2 {
+			for _, option := range strings.Split(parts[1], ",") {
+				switch {
+				case option == "ro":
+					mount.ReadOnly = true
+				case option == "rw":
+					mount.ReadOnly = false
+				case strings.HasPrefix(option, "size="):
+					if _, err := parseQuantity(strings.TrimPrefix(option, "size=")); err != nil {
+						return nil, fmt.Errorf("invalid tmpfs %s for service %s: %v", spec, name, err)
+					}
+					c.report.skipf(name, "tmpfs", "Ignoring the size of tmpfs %s of service %s, memory-backed volumes cannot be limited in this API version", parts[0], name)
+				case strings.HasPrefix(option, "mode="):
+					if mode, err := strconv.ParseUint(strings.TrimPrefix(option, "mode="), 8, 32); err != nil || mode > 07777 {
+						return nil, fmt.Errorf("invalid tmpfs %s for service %s: %s is not an octal mode", spec, name, strings.TrimPrefix(option, "mode="))
+					}
+					c.report.skipf(name, "tmpfs", "Ignoring the mode of tmpfs %s of service %s, the mode of emptyDir volumes cannot be set", parts[0], name)
+				default:
+					c.report.skipf(name, "tmpfs", "Ignoring option %s of tmpfs %s of service %s", option, parts[0], name)
+				}
+			}
+		}
+		source := api.VolumeSource{EmptyDir: &api.EmptyDirVolumeSource{Medium: api.StorageMediumMemory}}
+		volumes = append(volumes, api.Volume{Name: volumeName, VolumeSource: source})
+		volumemounts = append(volumemounts, mount)
+	}
+	// shm_size enlarges /dev/shm, which a memory-backed volume replaces. As
+	// for tmpfs, the size is only validated.
+	if extras.ShmSize != "" {
+		if _, err := parseQuantity(extras.ShmSize); err != nil {
+			return nil, fmt.Errorf("invalid shm_size for service %s: %v", name, err)
+		}
+		c.report.skipf(name, "shm_size", "Ignoring the size of /dev/shm of service %s, memory-backed volumes cannot be limited in this API version", name)
+		source := api.VolumeSource{EmptyDir: &api.EmptyDirVolumeSource{Medium: api.StorageMediumMemory}}
+		volumes = append(volumes, api.Volume{Name: "shm", VolumeSource: source})
+		volumemounts = append(volumemounts, api.VolumeMount{Name: "shm", MountPath: "/dev/shm"})
+	}
+	rc.Spec.Template.Spec.Containers[0].VolumeMounts = volumemounts
+	rc.Spec.Template.Spec.Volumes = volumes
+
+	// Mount the secrets used by the service.
+	for _, ref := range extras.Secrets {
+		config, ok := c.extras.Secrets[ref.Source]
+		if !ok {
+			return nil, fmt.Errorf("undefined secret %s for service %s", ref.Source, name)
+		}
+		source := api.VolumeSource{
+			Secret: &api.SecretVolumeSource{
+				SecretName: c.fileObjectName(ref.Source, config),
+				Items:      []api.KeyToPath{{Key: ref.Source, Path: ref.Source, Mode: ref.Mode}},
+			},
+		}
+		volumeName := "secret-" + kubeName(ref.Source, fileObjectConfig{})
+		mountFileObject(&rc.Spec.Template.Spec, volumeName, ref.Source, ref.targetPath("/run/secrets"), source)
+	}
+
+	// Mount the configs used by the service.
+	for _, ref := range extras.Configs {
+		config, ok := c.extras.Configs[ref.Source]
+		if !ok {
+			return nil, fmt.Errorf("undefined config %s for service %s", ref.Source, name)
+		}
+		source := api.VolumeSource{
+			ConfigMap: &api.ConfigMapVolumeSource{
+				LocalObjectReference: api.LocalObjectReference{Name: c.fileObjectName(ref.Source, config)},
+				Items:                []api.KeyToPath{{Key: ref.Source, Path: ref.Source, Mode: ref.Mode}},
+			},
+		}
+		volumeName := "config-" + kubeName(ref.Source, fileObjectConfig{})
+		mountFileObject(&rc.Spec.Template.Spec, volumeName, ref.Source, ref.targetPath("/"), source)
+	}
+
+	// Configure the container resources.
+	resources, err := c.containerResources(name, extras)
+	if err != nil {
+		return nil, fmt.Errorf("invalid resources for service %s: %v", name, err)
+	}
+	rc.Spec.Template.Spec.Containers[0].Resources = resources
+
+	// Configure the container restart policy.
+	restart := service.Restart
+	if restart == "" {
+		restart = c.opts.DefaultRestart
+	}
+	switch restart {
+	case "", "always", "unless-stopped":
+		rc.Spec.Template.Spec.RestartPolicy = api.RestartPolicyAlways
+	case "no":
+		rc.Spec.Template.Spec.RestartPolicy = api.RestartPolicyNever
+	case "on-failure":
+		rc.Spec.Template.Spec.RestartPolicy = api.RestartPolicyOnFailure
+	default:
+		return nil, fmt.Errorf("unknown restart policy %s for service %s", service.Restart, name)
+	}
+
+	// Configure how the container is stopped. Kubernetes always sends
+	// SIGTERM, so the stop signal is only preserved as an annotation.
+	if extras.StopGracePeriod != "" {
+		period, err := time.ParseDuration(extras.StopGracePeriod)
+		if err != nil || period < 0 {
+			return nil, fmt.Errorf("invalid stop_grace_period %s for service %s", extras.StopGracePeriod, name)
+		}
+		seconds := int64((period + time.Second - 1) / time.Second)
+		rc.Spec.Template.Spec.TerminationGracePeriodSeconds = &seconds
+	}
+	if extras.StopSignal != "" {
+		rc.Spec.Template.Annotations[stopSignalAnnotation] = extras.StopSignal
+	}
+
+	// Preserve the MAC address for CNI plugins that read it from the pod.
+	if service.MacAddress != "" {
+		mac, err := net.ParseMAC(service.MacAddress)
+		if err != nil || len(mac) != 6 {
+			return nil, fmt.Errorf("invalid mac_address %s for service %s", service.MacAddress, name)
+		}
+		rc.Spec.Template.Annotations[macAddressAnnotation] = mac.String()
+	}
+
+	// Preserve the OOM killer settings. A negative score adjustment asks
+	// for the container to be spared, which is the closest Kubernetes gets
+	// with the Guaranteed QoS class, so requests are raised to the limits.
+	if extras.OomScoreAdj != nil {
+		if *extras.OomScoreAdj < -1000 || *extras.OomScoreAdj > 1000 {
+			return nil, fmt.Errorf("invalid oom_score_adj %d for service %s", *extras.OomScoreAdj, name)
+		}
+		rc.Spec.Template.Annotations[oomScoreAdjAnnotation] = strconv.Itoa(*extras.OomScoreAdj)
+		container := &rc.Spec.Template.Spec.Containers[0]
+		if *extras.OomScoreAdj < 0 && container.Resources.Limits != nil && container.Resources.Requests == nil {
+			container.Resources.Requests = container.Resources.Limits
+		}
+	}
+	if extras.OomKillDisable {
+		rc.Spec.Template.Annotations[oomKillDisableAnnotation] = "true"
+	}
+
+	// Kubernetes cannot inject an init process, so record the request and
+	// optionally run the command under a tini binary shipped in the image.
+	if extras.Init {
+		rc.Spec.Template.Annotations[initAnnotation] = "true"
+		if c.opts.InjectTini {
+			container := &rc.Spec.Template.Spec.Containers[0]
+			if len(container.Command) == 0 {
+				c.report.serviceWarnf(name, "Cannot inject tini for service %s without a command, the image entrypoint is unknown", name)
+			} else {
+				container.Command = append([]string{"tini", "--"}, container.Command...)
+			}
+		}
+	}
+
+	// Translate the swarm placement constraints, the platform, the topology
+	// spread labels and the dependencies to colocate with. Placement
+	// constraints take precedence over the platform. The API version used
+	// here takes affinity as an annotation.
+	var affinity api.Affinity
+	if deploy := extras.Deploy; deploy != nil {
+		nodeSelector, nodeAffinity := c.placementConstraints(name, deploy.Placement.Constraints)
+		rc.Spec.Template.Spec.NodeSelector = nodeSelector
+		affinity.NodeAffinity = nodeAffinity
+	}
+	if extras.Platform != "" {
+		for label, value := range c.platformNodeSelector(name, extras.Platform) {
+			if rc.Spec.Template.Spec.NodeSelector == nil {
+				rc.Spec.Template.Spec.NodeSelector = make(map[string]string)
+			}
+			if _, ok := rc.Spec.Template.Spec.NodeSelector[label]; !ok {
+				rc.Spec.Template.Spec.NodeSelector[label] = value
+			}
+		}
+	}
+	affinity.PodAntiAffinity, err = topologySpread(service.Labels, rc.Spec.Replicas, rc.Spec.Selector)
+	if err != nil {
+		return nil, fmt.Errorf("invalid topology spread for service %s: %v", name, err)
+	}
+	if c.opts.ColocateDependencies {
+		deps := make([]string, len(service.DependsOn))
+		for i, dep := range service.DependsOn {
+			deps[i] = c.objectName(dep)
+		}
+		affinity.PodAffinity = colocation(deps)
+	}
+	if affinity.NodeAffinity != nil || affinity.PodAffinity != nil || affinity.PodAntiAffinity != nil {
+		data, err := json.Marshal(affinity)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal the affinity for service %s: %v", name, err)
+		}
+		rc.Spec.Template.Annotations[api.AffinityAnnotationKey] = string(data)
+	}
+
+	// The API version used here also takes tolerations as an annotation.
+	podTolerations, err := tolerations(service.Labels)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s label for service %s: %v", tolerationsLabel, name, err)
+	}
+	if len(podTolerations) > 0 {
+		data, err := json.Marshal(podTolerations)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal the tolerations for service %s: %v", name, err)
+		}
+		rc.Spec.Template.Annotations[api.TolerationsAnnotationKey] = string(data)
+	}
+
+	if c.opts.WaitForDependencies && len(service.DependsOn) > 0 {
+		var waits []api.Container
+		for _, dep := range service.DependsOn {
+			var port int32
+			switch condition := extras.DependsOn[dep].Condition; condition {
+			case "", conditionStarted:
+			case conditionHealthy:
+				depService, ok := c.configs.Get(dep)
+				if !ok {
+					return nil, fmt.Errorf("service %s depends on unknown service %s", name, dep)
+				}
+				for _, depPort := range depService.Ports {
+					portNumber, err := containerPort(depPort)
+					if err != nil {
+						return nil, fmt.Errorf("invalid container port %s for service %s", depPort, dep)
+					}
+					if port == 0 || portNumber < port {
+						port = portNumber
+					}
+				}
+			case conditionCompleted:
+				c.report.skipf(name, "depends_on", "Not waiting for %s to complete for service %s, completion cannot be checked from another pod", dep, name)
+				continue
+			default:
+				return nil, fmt.Errorf("unknown depends_on condition %s for service %s, must be one of %s, %s or %s", condition, name, conditionStarted, conditionHealthy, conditionCompleted)
+			}
+			// Without a network the dependency has no service, and its name
+			// never resolves.
+			if depService, ok := c.configs.Get(dep); ok && depService.NetworkMode == "none" {
+				c.report.skipf(name, "depends_on", "Not waiting for %s for service %s, it has network_mode none and cannot be reached", dep, name)
+				continue
+			}
+			if port == 0 && extras.DependsOn[dep].Condition == conditionHealthy {
+				c.report.serviceWarnf(name, "Service %s of service %s has no ports to check its health, waiting until it resolves instead", dep, name)
+			}
+			waits = append(waits, newDependencyWait(dep, c.objectName(dep), port))
+		}
+		if len(waits) > 0 {
+			data, err := json.Marshal(waits)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal the init containers for service %s: %v", name, err)
+			}
+			rc.Spec.Template.Annotations[initContainersAnnotation] = string(data)
+		}
+	}
+
+	// Kubernetes has no per-pod resource limits and none of the ulimits
+	// correspond to a namespaced sysctl, so they are preserved as
+	// soft:hard annotations for node level tooling.
+	for _, ulimit := range service.Ulimits.Elements {
+		value := fmt.Sprintf("%d:%d", ulimit.Soft, ulimit.Hard)
+		rc.Spec.Template.Annotations[ulimitAnnotationPrefix+ulimit.Name] = value
+	}
+
+	// Kubernetes places pods in cgroups of its own, so the parent cgroup is
+	// only preserved for node level tooling.
+	if service.CgroupParent != "" {
+		if !cgroupPath.MatchString(service.CgroupParent) {
+			c.report.skipf(name, "cgroup_parent", "Ignoring invalid cgroup_parent %s for service %s", service.CgroupParent, name)
+		} else {
+			rc.Spec.Template.Annotations[cgroupParentAnnotation] = service.CgroupParent
+		}
+	}
+
+	// Harden the pods as asked by the kompose.security labels.
+	securityContext, seccomp, err := podSecurityContext(service.Labels)
+	if err != nil {
+		return nil, fmt.Errorf("invalid security context for service %s: %v", name, err)
+	}
+	rc.Spec.Template.Spec.SecurityContext = securityContext
+	if seccomp != "" {
+		rc.Spec.Template.Annotations[seccompPodAnnotation] = seccomp
+	}
+	// A read only container has a read only root filesystem, like its
+	// read only mounts.
+	if service.ReadOnly {
+		readOnly := true
+		rc.Spec.Template.Spec.Containers[0].SecurityContext = &api.SecurityContext{ReadOnlyRootFilesystem: &readOnly}
+	}
+
+	// Only the namespaced sysctls can be set for a pod. The kubelet allows
+	// the safe ones, while the others must be allowed on the node.
+	safeValues, unsafeValues, nodeSysctls := sysctlAnnotations(extras.Sysctls)
+	if safeValues != "" {
+		rc.Spec.Template.Annotations[sysctlsPodAnnotation] = safeValues
+	}
+	if unsafeValues != "" {
+		rc.Spec.Template.Annotations[unsafeSysctlsPodAnnotation] = unsafeValues
+		c.report.serviceWarnf(name, "The sysctls %s of service %s are unsafe, the kubelet of the nodes must allow them with --experimental-allowed-unsafe-sysctls", unsafeValues, name)
+	}
+	for _, sysctl := range nodeSysctls {
+		c.report.skipf(name, "sysctls", "Ignoring sysctl %s for service %s, it is not namespaced and can only be set on the node", sysctl, name)
+	}
+
+	// Block IO throttling, device cgroup rules and the isolation technology
+	// have no Kubernetes equivalent.
+	if extras.BlkioConfig != nil {
+		c.report.skipf(name, "blkio_config", "Ignoring blkio_config for service %s, it has no Kubernetes equivalent", name)
+	}
+	if len(extras.DeviceCgroupRules) > 0 {
+		c.report.skipf(name, "device_cgroup_rules", "Ignoring device_cgroup_rules for service %s, it has no Kubernetes equivalent", name)
+	}
+	if extras.Isolation != "" {
+		c.report.skipf(name, "isolation", "Ignoring isolation for service %s, it has no Kubernetes equivalent", name)
+	}
+	if extras.MemSwappiness != nil {
+		c.report.skipf(name, "mem_swappiness", "Ignoring mem_swappiness for service %s, it has no Kubernetes equivalent", name)
+	}
+
+	// The API version used here predates RuntimeClasses, so the runtime is
+	// kept as an annotation.
+	if runtime := extras.Runtime; runtime != "" {
+		if errs := validation.IsDNS1123Subdomain(runtime); len(errs) > 0 {
+			c.report.skipf(name, "runtime", "Ignoring unrecognized runtime %s for service %s", runtime, name)
+		} else {
+			rc.Spec.Template.Annotations[runtimeAnnotation] = runtime
+		}
+	}
+
+	// Request the GPUs asked for with the kompose.gpu label.
+	gpuName, gpus, err := gpuLimit(service.Labels)
+	if err != nil {
+		return nil, fmt.Errorf("invalid GPU request for service %s: %v", name, err)
+	}
+	if gpuName != "" {
+		container := &rc.Spec.Template.Spec.Containers[0]
+		if container.Resources.Limits == nil {
+			container.Resources.Limits = api.ResourceList{}
+		}
+		container.Resources.Limits[gpuName] = gpus
+	}
+
+	if c.opts.DefaultDeny {
+		for _, network := range serviceNetworks(service) {
+			networkName, err := c.networkName(network)
+			if err != nil {
+				return nil, fmt.Errorf("service %s: %v", name, err)
+			}
+			rc.Spec.Template.Labels[networkLabelPrefix+networkName] = "true"
+		}
+	}
+
+	// The service label of the pod template and the selector is what ties
+	// the controller to its pods, so only the controller's own labels can
+	// be dropped.
+	if c.opts.StripLabels {
+		rc.Labels = nil
+	}
+
+	headless := false
+	if value, ok := service.Labels[headlessLabel]; ok {
+		if headless, err = strconv.ParseBool(value); err != nil {
+			return nil, fmt.Errorf("invalid %s label %q for service %s", headlessLabel, value, name)
+		}
+	}
+
+	// Web apps always run as a deployment, reachable through a service and
+	// optionally an ingress. Other workloads only get a service when it is
+	// headless, which stateful sets always need. The pods of a stateful set
+	// are in the subdomain of that service, so that each of them gets its
+	// own DNS name.
+	controllerKind := c.opts.Controller
+	if c.opts.InferController {
+		controllerKind = inferController(restart)
+	}
+	switch kind := service.Labels[serviceKindLabel]; kind {
+	case "":
+		if noNetwork && controllerKind == ControllerStatefulSet {
+			return nil, fmt.Errorf("the %s controller cannot be combined with network_mode none for service %s, a stateful set needs a headless service", ControllerStatefulSet, name)
+		}
+		if noNetwork {
+			break
+		}
+		// The services waited for must resolve, or the init containers
+		// waiting for them never finish.
+		if headless || controllerKind == ControllerStatefulSet || c.waited[name] {
+			objects = append(objects, newService(name, rc, rc.Spec.Template.Spec.Containers[0].Ports, true))
+		}
+		if controllerKind == ControllerStatefulSet {
+			rc.Spec.Template.Spec.Subdomain = rc.Name
+		}
+	case serviceKindWebApp:
+		if noNetwork {
+			return nil, fmt.Errorf("the %s %s label cannot be combined with network_mode none for service %s", serviceKindLabel, kind, name)
+		}
+		controllerKind = ControllerDeployment
+		webObjects, err := newWebApp(name, rc, service.Labels[exposeLabel], headless)
+		if err != nil {
+			return nil, fmt.Errorf("invalid web app %s: %v", name, err)
+		}
+		objects = append(objects, webObjects...)
+	default:
+		return nil, fmt.Errorf("unknown %s label %s for service %s", serviceKindLabel, kind, name)
+	}
+
+	// A ServiceMonitor selects the service in front of the pods, so one is
+	// added when the workload has none, and scrapes the metrics port of the
+	// pods, which is declared when the compose file does not publish it.
+	port, scrape, err := metricsPort(service.Labels)
+	if err != nil {
+		return nil, fmt.Errorf("invalid metrics labels for service %s: %v", name, err)
+	}
+	if scrape {
+		if noNetwork {
+			return nil, fmt.Errorf("the %s label cannot be combined with network_mode none for service %s", metricsPortLabel, name)
+		}
+		if c.opts.StripLabels {
+			return nil, fmt.Errorf("the %s label of service %s cannot be combined with stripping labels, the ServiceMonitor selects the service by its labels", metricsPortLabel, name)
+		}
+		container := &rc.Spec.Template.Spec.Containers[0]
+		declared := false
+		for _, p := range container.Ports {
+			declared = declared || p.ContainerPort == port
+		}
+		if !declared {
+			container.Ports = append(container.Ports, api.ContainerPort{ContainerPort: port})
+		}
+		hasService := false
+		for _, obj := range objects {
+			hasService = hasService || obj.Kind == "Service"
+		}
+		if !hasService {
+			objects = append(objects, newService(name, rc, container.Ports, false))
+		}
+		monitor, err := newServiceMonitor(rc.Name, rc.Labels, port, service.Labels)
+		if err != nil {
+			return nil, fmt.Errorf("invalid metrics labels for service %s: %v", name, err)
+		}
+		objects = append(objects, Object{
+			Object:   monitor,
+			Service:  name,
+			Kind:     monitor.Kind,
+			Name:     monitor.Name,
+			BaseName: monitor.Name + "-servicemonitor",
+		})
+	}
+
+	controller, err := c.controller(name, rc, controllerKind)
+	if err != nil {
+		return nil, err
+	}
+	return append(objects, controller), nil
+}
+
+// controller creates a controller of the given kind for rc, optionally
+// wrapped in a custom resource.
+func (c *converter) controller(name string, rc *api.ReplicationController, controllerKind string) (Object, error) {
+	var obj runtime.Object = rc
+	kind, meta, spec, suffix := rc.Kind, rc.ObjectMeta, interface{}(rc.Spec), controllerKind
+	extras := c.extras.Services[name]
+
+	// Replication controllers, deployments and stateful sets keep their pods
+	// running, so they only accept the Always restart policy.
+	switch controllerKind {
+	case ControllerRC, ControllerDeployment, ControllerStatefulSet:
+		if policy := rc.Spec.Template.Spec.RestartPolicy; policy != api.RestartPolicyAlways {
+			c.report.serviceWarnf(name, "Restarting the pods of service %s always instead of %s, only jobs and bare pods can run to completion", name, policy)
+			rc.Spec.Template.Spec.RestartPolicy = api.RestartPolicyAlways
+		}
+	}
+
+	switch controllerKind {
+	case ControllerPod:
+		if rc.Spec.Replicas > 1 {
+			c.report.serviceWarnf(name, "Ignoring %d replicas for service %s, a bare pod always runs once", rc.Spec.Replicas, name)
+		}
+		pod := newPod(rc)
+		obj, kind, meta, spec = pod, pod.Kind, pod.ObjectMeta, pod.Spec
+	case ControllerDeployment:
+		deployment := newDeployment(rc)
+		if deploy := extras.Deploy; deploy != nil && deploy.UpdateConfig != nil {
+			strategy, err := deploymentStrategy(deploy.UpdateConfig)
+			if err != nil {
+				return Object{}, fmt.Errorf("invalid deploy.update_config for service %s: %v", name, err)
+			}
+			deployment.Spec.Strategy = strategy
+		}
+		if service, ok := c.configs.Get(name); ok {
+			if value, ok := service.Labels[progressDeadlineLabel]; ok {
+				deadline, err := progressDeadline(value)
+				if err != nil {
+					return Object{}, fmt.Errorf("invalid %s label for service %s: %v", progressDeadlineLabel, name, err)
+				}
+				deployment.Spec.ProgressDeadlineSeconds = deadline
+			}
+		}
+		deployment.Spec.RevisionHistoryLimit = c.opts.RevisionHistoryLimit
+		if service, ok := c.configs.Get(name); ok {
+			if value, ok := service.Labels[revisionHistoryLimitLabel]; ok {
+				limit, err := revisionHistoryLimit(value)
+				if err != nil {
+					return Object{}, fmt.Errorf("invalid %s label for service %s: %v", revisionHistoryLimitLabel, name, err)
+				}
+				deployment.Spec.RevisionHistoryLimit = limit
+			}
+		}
+		obj, kind, meta, spec = deployment, deployment.Kind, deployment.ObjectMeta, deployment.Spec
+	case ControllerStatefulSet:
+		statefulSet := newStatefulSet(rc, rc.Name)
+		obj, kind, meta, spec = statefulSet, statefulSet.Kind, statefulSet.ObjectMeta, statefulSet.Spec
+	case ControllerJob:
+		var policy *restartPolicy
+		if deploy := extras.Deploy; deploy != nil {
+			policy = deploy.RestartPolicy
+		}
+		job, err := c.newJob(name, rc, policy)
+		if err != nil {
+			return Object{}, fmt.Errorf("invalid deploy.restart_policy for service %s: %v", name, err)
+		}
+		obj, kind, meta, spec = job, job.Kind, job.ObjectMeta, job.Spec
+	}
+	if c.opts.Fragment == FragmentPodTemplate {
+		return Object{
+			Object:   &podTemplateFragment{*rc.Spec.Template},
+			Service:  name,
+			Kind:     "PodTemplateSpec",
+			Name:     rc.Name,
+			BaseName: rc.Name + "-podtemplate",
+		}, nil
+	}
+	if c.opts.WrapCRD != nil {
+		obj = newCustomResource(*c.opts.WrapCRD, meta, spec)
+		kind, suffix = c.opts.WrapCRD.Kind, strings.ToLower(c.opts.WrapCRD.Kind)
+	}
+
+	return Object{
+		Object:   obj,
+		Service:  name,
+		Kind:     kind,
+		Name:     rc.Name,
+		BaseName: rc.Name + "-" + suffix,
+	}, nil
+}
+
+// objectName returns the name of the object generated for the compose
+// object name, with the name prefix of the conversion.
+func (c *converter) objectName(name string) string {
+	return c.opts.NamePrefix + name
+}
+
+// fileObjectName returns the name of the Kubernetes object for a compose
+// secret or config. External objects keep their name, as they must match an
+// existing object.
+func (c *converter) fileObjectName(name string, config fileObjectConfig) string {
+	if config.External {
+		return kubeName(name, config)
+	}
+	return c.objectName(kubeName(name, config))
+}
+
+// build describes how the image of a service is built. A relative build
+// context is resolved against the directory of the compose file.
+func (c *converter) build(name string, service *config.ServiceConfig) (Build, error) {
+	image := service.Image
+	if image == "" {
+		image = name
+	}
+	context := service.Build.Context
+	if !filepath.IsAbs(context) {
+		var err error
+		context, err = filepath.Abs(filepath.Join(filepath.Dir(c.composeFile), context))
+		if err != nil {
+			return Build{}, fmt.Errorf("failed to resolve build context for service %s: %v", name, err)
+		}
+	}
+	return Build{Image: image, Context: context, Dockerfile: service.Build.Dockerfile}, nil
+}
+
+// selectServices filters the service names in keys. Services with profiles
+// are dropped unless one of their profiles is enabled or they are listed in
+// Only, as naming a service enables it like Docker Compose does. With Only,
+// just the listed services are kept, otherwise the services listed in Skip
+// are removed. Listing an unknown service is an error.
+func (c *converter) selectServices(keys []string) ([]string, error) {
+	only, skip := c.opts.Only, c.opts.Skip
+	known := make(map[string]bool, len(keys))
+	for _, name := range keys {
+		known[name] = true
+	}
+	listed := make(map[string]bool)
+	for _, name := range append(only, skip...) {
+		if !known[name] {
+			return nil, fmt.Errorf("unknown service %s", name)
+		}
+		listed[name] = true
+	}
+
+	enabled := make(map[string]bool, len(c.opts.Profiles))
+	for _, profile := range c.opts.Profiles {
+		enabled[profile] = true
+	}
+	var selected []string
+	for _, name := range keys {
+		if profiles := c.extras.Services[name].Profiles; len(profiles) > 0 && !anyEnabled(profiles, enabled) && !(len(only) > 0 && listed[name]) {
+			continue
+		}
+		if len(only) > 0 && !listed[name] || len(skip) > 0 && listed[name] {
+			continue
+		}
+		selected = append(selected, name)
+	}
+	return selected, nil
+}
+
+// anyEnabled reports whether any of profiles is enabled.
+func anyEnabled(profiles []string, enabled map[string]bool) bool {
+	for _, profile := range profiles {
+		if enabled[profile] {
+			return true
+		}
+	}
+	return false
+}
+
+// containerPort returns the container side of a compose port mapping.
+func containerPort(port string) (int32, error) {
+	_, portNumber, err := parsePort(port)
+	return portNumber, err
+}
+
+// parsePort parses a compose port in the CONTAINER, HOST:CONTAINER or
+// IP:HOST:CONTAINER form, returning the host IP the port is bound to, if
+// any, and the container port.
+func parsePort(port string) (string, int32, error) {
+	port = strings.Trim(port, "\"")
+	port = strings.TrimSpace(port)
+	hostIP := ""
+	// The container port comes last, and a host IP before the host port,
+	// which keeps an IPv6 address whole.
+	if i := strings.LastIndex(port, ":"); i >= 0 {
+		mapping := port[:i]
+		port = port[i+1:]
+		if j := strings.LastIndex(mapping, ":"); j >= 0 {
+			hostIP = strings.Trim(mapping[:j], "[]")
+		}
+	}
+	portNumber, err := strconv.ParseInt(port, 10, 32)
+	if err != nil {
+		return "", 0, err
+	}
+	if portNumber < 1 || portNumber > 65535 {
+		return "", 0, fmt.Errorf("port %d is out of range", portNumber)
+	}
+	return hostIP, int32(portNumber), nil
+}
+
+// containerCommand returns the command for a container. A command given as a
+// string in the compose file, shellCommand, is run by the shell like Docker
+// does, while a list is used as is. An empty command, or one made up of
+// blank strings only, is treated as unset so the default command of the
+// image is used.
+func containerCommand(command []string, shellCommand string) []string {
+	if strings.TrimSpace(shellCommand) != "" {
+		return []string{"/bin/sh", "-c", shellCommand}
+	}
+	for _, arg := range command {
+		if strings.TrimSpace(arg) != "" {
+			return command
+		}
+	}
+	return nil
+}
+
+// emitted reports whether kind is one of EmittedKinds.
+func emitted(kind string) bool {
+	for _, k := range EmittedKinds {
+		if k == kind {
+			return true
+		}
+	}
+	return false
+}
+
+// hasLabelPrefix reports whether any of labels starts with prefix.
+func hasLabelPrefix(labels map[string]string, prefix string) bool {
+	for key := range labels {
+		if strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// sortedKeys returns the keys of m in sorted order.
+func sortedKeys(m map[string]fileObjectConfig) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
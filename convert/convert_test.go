@@ -0,0 +1,516 @@
+/*
+Copyright 2015 Kelsey Hightower All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package convert
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/apis/apps"
+	"k8s.io/kubernetes/pkg/apis/batch"
+	"k8s.io/kubernetes/pkg/apis/extensions"
+	"k8s.io/kubernetes/pkg/util/validation"
+)
+
+// writeProject writes compose as the docker-compose.yml of a temporary
+// directory, together with files, keyed by their path relative to it. It
+// returns the path of the compose file.
+func writeProject(t *testing.T, compose string, files map[string]string) string {
+	t.Helper()
+	dir := t.TempDir()
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	composeFile := filepath.Join(dir, "docker-compose.yml")
+	if err := ioutil.WriteFile(composeFile, []byte(compose), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return composeFile
+}
+
+// convertProject converts compose, written with files by writeProject.
+func convertProject(t *testing.T, compose string, files map[string]string, opts Options) *Result {
+	t.Helper()
+	result, err := Convert(writeProject(t, compose, files), opts)
+	if err != nil {
+		t.Fatalf("Convert: %v", err)
+	}
+	return result
+}
+
+// findObject returns the object of the given kind and name.
+func findObject(t *testing.T, result *Result, kind, name string) Object {
+	t.Helper()
+	for _, obj := range result.Objects {
+		if obj.Kind == kind && obj.Name == name {
+			return obj
+		}
+	}
+	t.Fatalf("no %s %s among the generated objects", kind, name)
+	return Object{}
+}
+
+// podSpec returns the pod spec of the replication controller name.
+func podSpec(t *testing.T, result *Result, name string) *api.PodSpec {
+	t.Helper()
+	rc := findObject(t, result, "ReplicationController", name).Object.(*api.ReplicationController)
+	return &rc.Spec.Template.Spec
+}
+
+// objectPodSpec returns the pod spec of a controller or bare pod of any kind.
+func objectPodSpec(t *testing.T, obj Object) *api.PodSpec {
+	t.Helper()
+	switch o := obj.Object.(type) {
+	case *api.ReplicationController:
+		return &o.Spec.Template.Spec
+	case *api.Pod:
+		return &o.Spec
+	case *extensions.Deployment:
+		return &o.Spec.Template.Spec
+	case *batch.Job:
+		return &o.Spec.Template.Spec
+	case *apps.StatefulSet:
+		return &o.Spec.Template.Spec
+	}
+	t.Fatalf("%s %s has no pod spec", obj.Kind, obj.Name)
+	return nil
+}
+
+// annotations returns the annotations of obj.
+func annotations(t *testing.T, obj Object) map[string]string {
+	t.Helper()
+	meta, err := api.ObjectMetaFor(obj.Object)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return meta.Annotations
+}
+
+// convertFixture converts the compose project in the named directory of
+// testdata.
+func convertFixture(t *testing.T, name string, opts Options) *Result {
+	t.Helper()
+	result, err := Convert(filepath.Join("testdata", name), opts)
+	if err != nil {
+		t.Fatalf("Convert: %v", err)
+	}
+	return result
+}
+
+// toJSON encodes v for test failure messages, where the pointers within
+// API objects would otherwise be printed as addresses.
+func toJSON(v interface{}) string {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err.Error()
+	}
+	return string(data)
+}
+
+// threeServices has services without dependencies between them.
+const threeServices = `version: "2"
+services:
+  web:
+    image: nginx
+  api:
+    image: api
+  db:
+    image: postgres
+`
+
+// controllerNames returns the names of the replication controllers in
+// result, in order.
+func controllerNames(result *Result) []string {
+	var names []string
+	for _, obj := range result.Objects {
+		if obj.Kind == "ReplicationController" {
+			names = append(names, obj.Name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+func TestServiceFilters(t *testing.T) {
+	tests := []struct {
+		opts Options
+		want []string
+	}{
+		{Options{}, []string{"api", "db", "web"}},
+		{Options{Only: []string{"web", "api"}}, []string{"api", "web"}},
+		{Options{Skip: []string{"db"}}, []string{"api", "web"}},
+	}
+	for _, test := range tests {
+		result := convertProject(t, threeServices, nil, test.opts)
+		if got := controllerNames(result); !reflect.DeepEqual(got, test.want) {
+			t.Errorf("only %q, skip %q: got %q, want %q", test.opts.Only, test.opts.Skip, got, test.want)
+		}
+	}
+}
+
+func TestServiceFilterErrors(t *testing.T) {
+	for _, opts := range []Options{
+		{Only: []string{"web"}, Skip: []string{"db"}},
+		{Only: []string{"cache"}},
+		{Skip: []string{"cache"}},
+	} {
+		if _, err := Convert(writeProject(t, threeServices, nil), opts); err == nil {
+			t.Errorf("only %q, skip %q: converted, want an error", opts.Only, opts.Skip)
+		}
+	}
+}
+
+func TestProfiles(t *testing.T) {
+	const compose = `version: "3.9"
+services:
+  web:
+    image: nginx
+  debug:
+    image: busybox
+    profiles: ["debug"]
+  seed:
+    image: busybox
+    profiles: ["debug", "init"]
+`
+	tests := []struct {
+		opts Options
+		want []string
+	}{
+		{Options{}, []string{"web"}},
+		{Options{Profiles: []string{"debug"}}, []string{"debug", "seed", "web"}},
+		{Options{Profiles: []string{"init"}}, []string{"seed", "web"}},
+		{Options{Only: []string{"debug"}}, []string{"debug"}},
+		{Options{Only: []string{"web"}, Profiles: []string{"debug"}}, []string{"web"}},
+		{Options{Skip: []string{"web"}, Profiles: []string{"init"}}, []string{"seed"}},
+	}
+	for _, test := range tests {
+		result := convertProject(t, compose, nil, test.opts)
+		if got := controllerNames(result); !reflect.DeepEqual(got, test.want) {
+			t.Errorf("profiles %q, only %q, skip %q: got %q, want %q", test.opts.Profiles, test.opts.Only, test.opts.Skip, got, test.want)
+		}
+	}
+}
+
+func TestNamePrefix(t *testing.T) {
+	result := convertProject(t, `version: "3.1"
+services:
+  web:
+    image: nginx
+    ports:
+      - "80"
+    depends_on:
+      - db
+    labels:
+      kompose.service.kind: webapp
+  db:
+    image: postgres
+    ports:
+      - "5432"
+    secrets:
+      - password
+    volumes:
+      - dbdata:/var/lib/postgresql/data
+secrets:
+  password:
+    file: ./password.txt
+volumes:
+  dbdata:
+`, map[string]string{"password.txt": "secret"}, Options{NamePrefix: "shop-", WaitForDependencies: true})
+
+	for _, obj := range result.Objects {
+		meta, err := api.ObjectMetaFor(obj.Object)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !strings.HasPrefix(obj.Name, "shop-") || meta.Name != obj.Name {
+			t.Errorf("got %s named %s, %s, want the shop- prefix", obj.Kind, obj.Name, meta.Name)
+		}
+	}
+
+	// The selectors match the prefixed names, and so the pod labels.
+	deployment := findObject(t, result, "Deployment", "shop-web").Object.(*extensions.Deployment)
+	service := findObject(t, result, "Service", "shop-web").Object.(*api.Service)
+	webLabels := map[string]string{"service": "shop-web"}
+	if !reflect.DeepEqual(deployment.Spec.Selector.MatchLabels, webLabels) || !reflect.DeepEqual(service.Spec.Selector, webLabels) {
+		t.Errorf("got deployment selector %v and service selector %v, want %v", deployment.Spec.Selector.MatchLabels, service.Spec.Selector, webLabels)
+	}
+	if got := deployment.Spec.Template.Labels["service"]; got != "shop-web" {
+		t.Errorf("got pod label service=%s, want shop-web", got)
+	}
+	rc := findObject(t, result, "ReplicationController", "shop-db").Object.(*api.ReplicationController)
+	if want := map[string]string{"service": "shop-db"}; !reflect.DeepEqual(rc.Spec.Selector, want) {
+		t.Errorf("got selector %v, want %v", rc.Spec.Selector, want)
+	}
+
+	// The references to other objects use their prefixed names.
+	var claims, secrets []string
+	for _, volume := range rc.Spec.Template.Spec.Volumes {
+		if volume.PersistentVolumeClaim != nil {
+			claims = append(claims, volume.PersistentVolumeClaim.ClaimName)
+		}
+		if volume.Secret != nil {
+			secrets = append(secrets, volume.Secret.SecretName)
+		}
+	}
+	if !reflect.DeepEqual(claims, []string{"shop-dbdata"}) || !reflect.DeepEqual(secrets, []string{"shop-password"}) {
+		t.Errorf("got claims %q and secrets %q, want shop-dbdata and shop-password", claims, secrets)
+	}
+	var waits []api.Container
+	if err := json.Unmarshal([]byte(deployment.Spec.Template.Annotations[initContainersAnnotation]), &waits); err != nil {
+		t.Fatal(err)
+	}
+	if len(waits) != 1 || !strings.Contains(strings.Join(waits[0].Command, " "), "nslookup shop-db;") {
+		t.Errorf("got init containers %s, want one waiting for shop-db", toJSON(waits))
+	}
+}
+
+func TestParsePort(t *testing.T) {
+	tests := []struct {
+		port   string
+		hostIP string
+		want   int32
+	}{
+		{"80", "", 80},
+		{"8080:80", "", 80},
+		{"127.0.0.1:8080:80", "127.0.0.1", 80},
+		{"127.0.0.1::80", "127.0.0.1", 80},
+		{"[::1]:8080:80", "::1", 80},
+		{"::1:8080:80", "::1", 80},
+		{`"5432"`, "", 5432},
+	}
+	for _, test := range tests {
+		hostIP, port, err := parsePort(test.port)
+		if err != nil {
+			t.Errorf("parsePort(%q): %v", test.port, err)
+			continue
+		}
+		if hostIP != test.hostIP || port != test.want {
+			t.Errorf("parsePort(%q) = %q, %d, want %q, %d", test.port, hostIP, port, test.hostIP, test.want)
+		}
+	}
+	for _, port := range []string{"", "http", "127.0.0.1:8080:", "[::1]:8080:http", "0", "65536"} {
+		if _, _, err := parsePort(port); err == nil {
+			t.Errorf("parsePort(%q) succeeded, want an error", port)
+		}
+	}
+}
+
+func TestHostIPAnnotation(t *testing.T) {
+	result := convertProject(t, `version: "2"
+services:
+  web:
+    image: nginx
+    ports:
+      - "127.0.0.1:8080:80"
+      - "[::1]::443"
+      - "9000"
+`, nil, Options{})
+
+	rc := findObject(t, result, "ReplicationController", "web").Object.(*api.ReplicationController)
+	want := map[string]string{
+		hostIPAnnotationPrefix + "80":  "127.0.0.1",
+		hostIPAnnotationPrefix + "443": "::1",
+	}
+	for key, value := range want {
+		if got := rc.Spec.Template.Annotations[key]; got != value {
+			t.Errorf("got annotation %s %q, want %q", key, got, value)
+		}
+	}
+	if _, ok := rc.Spec.Template.Annotations[hostIPAnnotationPrefix+"9000"]; ok {
+		t.Errorf("got a host IP annotation for a port without a host IP")
+	}
+}
+
+func TestWorkingDir(t *testing.T) {
+	result := convertProject(t, `version: "2"
+services:
+  app:
+    image: app
+    working_dir: /srv/app
+  plain:
+    image: app
+`, nil, Options{})
+
+	if got := podSpec(t, result, "app").Containers[0].WorkingDir; got != "/srv/app" {
+		t.Errorf("got working dir %q, want /srv/app", got)
+	}
+	if got := podSpec(t, result, "plain").Containers[0].WorkingDir; got != "" {
+		t.Errorf("got working dir %q without working_dir, want the one of the image", got)
+	}
+}
+
+func TestEntrypoint(t *testing.T) {
+	result := convertProject(t, `version: "2"
+services:
+  list:
+    image: app
+    entrypoint: ["/docker-entrypoint.sh", "--verbose"]
+    command: ["serve", "--port", "80"]
+  string:
+    image: app
+    entrypoint: /docker-entrypoint.sh --verbose
+  command:
+    image: app
+    command: ["serve"]
+`, nil, Options{})
+
+	tests := []struct {
+		service string
+		command []string
+		args    []string
+	}{
+		// The entrypoint replaces the one of the image, and the command
+		// becomes its arguments.
+		{"list", []string{"/docker-entrypoint.sh", "--verbose"}, []string{"serve", "--port", "80"}},
+		{"string", []string{"/docker-entrypoint.sh", "--verbose"}, nil},
+		// Without an entrypoint, the command replaces the one of the image.
+		{"command", []string{"serve"}, nil},
+	}
+	for _, test := range tests {
+		container := podSpec(t, result, test.service).Containers[0]
+		if !reflect.DeepEqual(container.Command, test.command) || !reflect.DeepEqual(container.Args, test.args) {
+			t.Errorf("%s: got command %q and args %q, want %q and %q", test.service, container.Command, container.Args, test.command, test.args)
+		}
+	}
+}
+
+func TestExternalLinks(t *testing.T) {
+	result := convertProject(t, `version: "2"
+services:
+  web:
+    image: nginx
+    external_links:
+      - legacy_db_1:db
+      - redis_1
+      - other_db_1:db
+    environment:
+      REDIS_1_HOST: cache.example.com
+`, nil, Options{})
+
+	// Each alias names the container it refers to, the first link of an
+	// alias and the environment of the service winning.
+	want := []api.EnvVar{
+		{Name: "REDIS_1_HOST", Value: "cache.example.com"},
+		{Name: "DB_HOST", Value: "legacy_db_1"},
+	}
+	if got := podSpec(t, result, "web").Containers[0].Env; !reflect.DeepEqual(got, want) {
+		t.Errorf("got env %s, want %s", toJSON(got), toJSON(want))
+	}
+	if got := result.Report.Services["web"].Warnings; len(got) != 3 {
+		t.Errorf("got warnings %q, want one per external link", got)
+	}
+}
+
+func TestNoNetwork(t *testing.T) {
+	result := convertProject(t, `version: "2"
+services:
+  batch:
+    image: batch
+    network_mode: none
+    ports:
+      - "8080"
+    labels:
+      kompose.service.headless: "true"
+  web:
+    image: nginx
+    depends_on:
+      - batch
+`, nil, Options{WaitForDependencies: true})
+
+	// The pods still get a network, but no service routes to them, and
+	// nothing waits for them to resolve.
+	var objects []string
+	for _, obj := range result.Objects {
+		objects = append(objects, obj.Kind+"/"+obj.Name)
+	}
+	if want := []string{"ReplicationController/batch", "ReplicationController/web"}; !reflect.DeepEqual(objects, want) {
+		t.Errorf("got objects %q, want %q", objects, want)
+	}
+	if got := result.Report.Services["batch"].Skipped; !reflect.DeepEqual(got, []string{"network_mode"}) {
+		t.Errorf("got skipped options %q, want [network_mode]", got)
+	}
+	web := findObject(t, result, "ReplicationController", "web").Object.(*api.ReplicationController)
+	if got, ok := web.Spec.Template.Annotations[initContainersAnnotation]; ok {
+		t.Errorf("got init containers %s, want none", got)
+	}
+
+	_, err := Convert(writeProject(t, `version: "2"
+services:
+  web:
+    image: nginx
+    network_mode: none
+    ports:
+      - "80"
+    labels:
+      kompose.service.kind: webapp
+`, nil), Options{})
+	if err == nil || !strings.Contains(err.Error(), "network_mode none") {
+		t.Errorf("got error %v, want one about network_mode none", err)
+	}
+}
+
+func TestHostPathVolumeNames(t *testing.T) {
+	result := convertProject(t, `version: "2"
+services:
+  app:
+    image: app
+    volumes:
+      - /data/a_b:/a
+      - /data/a-b:/b
+      - /data/a_b:/c:ro
+      - /`+strings.Repeat("very-long-directory/", 4)+`data:/d
+`, nil, Options{})
+
+	// Paths differing only in the characters dropped get distinct names,
+	// and a path mounted twice is a single volume.
+	spec := podSpec(t, result, "app")
+	var names []string
+	for _, volume := range spec.Volumes {
+		names = append(names, volume.Name)
+		if errs := validation.IsDNS1123Label(volume.Name); len(errs) > 0 {
+			t.Errorf("got invalid volume name %s: %s", volume.Name, strings.Join(errs, ", "))
+		}
+		if !strings.HasPrefix(volume.Name, "data-a-b-") && !strings.HasPrefix(volume.Name, "very-long-directory-") {
+			t.Errorf("got volume name %s, want one readable from the path", volume.Name)
+		}
+	}
+	if len(names) != 3 || names[0] == names[1] {
+		t.Fatalf("got volumes %q, want three distinct volumes", names)
+	}
+	mounts := spec.Containers[0].VolumeMounts
+	if len(mounts) != 4 || mounts[0].Name != mounts[2].Name {
+		t.Errorf("got mounts %s, want /a and /c to share a volume", toJSON(mounts))
+	}
+
+	// The names only depend on the path.
+	if hostPathVolumeName("/data/a_b") != names[0] {
+		t.Errorf("hostPathVolumeName(/data/a_b) = %s, want %s", hostPathVolumeName("/data/a_b"), names[0])
+	}
+	if got := hostPathVolumeName("/"); got != "host-"+got[len(got)-10:] {
+		t.Errorf("hostPathVolumeName(/) = %s, want host followed by a hash", got)
+	}
+}
@@ -0,0 +1,97 @@
+/*
+Copyright 2015 Kelsey Hightower All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package convert
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"k8s.io/kubernetes/pkg/api"
+)
+
+// envFiles is the env_file option, which is either a single path or a list of
+// paths.
+type envFiles []string
+
+// UnmarshalYAML accepts both a single path and a list of paths.
+func (f *envFiles) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var path string
+	if err := unmarshal(&path); err == nil {
+		*f = envFiles{path}
+		return nil
+	}
+	var paths []string
+	if err := unmarshal(&paths); err != nil {
+		return err
+	}
+	*f = envFiles(paths)
+	return nil
+}
+
+// readEnvFile reads the variables of an env_file. Relative paths are resolved
+// against baseDir, the directory of the compose file, like Docker Compose
+// does. Each line holds a VAR=value pair, and a line with only a name takes
+// the value from the environment, if set. Blank lines and lines starting with
+// # are ignored.
+func (c *converter) readEnvFile(path, baseDir string) ([]api.EnvVar, error) {
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(baseDir, path)
+	}
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, fmt.Errorf("%s does not exist", path)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var envs []api.EnvVar
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		parts := strings.SplitN(line, "=", 2)
+		if len(parts) == 2 {
+			envs = append(envs, api.EnvVar{Name: parts[0], Value: parts[1]})
+		} else if value, ok := c.lookupEnv(line); ok {
+			envs = append(envs, api.EnvVar{Name: line, Value: value})
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %v", path, err)
+	}
+	return envs, nil
+}
+
+// setEnv sets the variable env in envs. An earlier value of the variable is
+// replaced when replace is set and kept otherwise. It reports whether envs
+// already had a value for the variable.
+func setEnv(envs []api.EnvVar, env api.EnvVar, replace bool) ([]api.EnvVar, bool) {
+	for i := range envs {
+		if envs[i].Name == env.Name {
+			if replace {
+				envs[i] = env
+			}
+			return envs, true
+		}
+	}
+	return append(envs, env), false
+}
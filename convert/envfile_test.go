@@ -0,0 +1,66 @@
+/*
+Copyright 2015 Kelsey Hightower All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package convert
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/kubernetes/pkg/api"
+)
+
+// duplicateEnv sets DATABASE_URL both in env_file and in environment.
+const duplicateEnv = `version: "2"
+services:
+  web:
+    image: nginx
+    env_file: web.env
+    environment:
+      - DATABASE_URL=postgres://db/app?sslmode=disable
+      - MODE=a=b
+`
+
+var duplicateEnvFiles = map[string]string{
+	"web.env": "DATABASE_URL=postgres://localhost/app\nDEBUG=1\n",
+}
+
+func TestDuplicateEnv(t *testing.T) {
+	result := convertProject(t, duplicateEnv, duplicateEnvFiles, Options{})
+
+	// environment wins over env_file, and values keep their = signs.
+	want := []api.EnvVar{
+		{Name: "DATABASE_URL", Value: "postgres://db/app?sslmode=disable"},
+		{Name: "DEBUG", Value: "1"},
+		{Name: "MODE", Value: "a=b"},
+	}
+	if got := podSpec(t, result, "web").Containers[0].Env; !reflect.DeepEqual(got, want) {
+		t.Errorf("got env %s, want %s", toJSON(got), toJSON(want))
+	}
+	if got := result.Report.Services["web"].Warnings; len(got) != 1 {
+		t.Errorf("got warnings %q, want one for DATABASE_URL", got)
+	}
+}
+
+func TestDuplicateEnvFirstWins(t *testing.T) {
+	result := convertProject(t, duplicateEnv, duplicateEnvFiles, Options{EnvFirstWins: true})
+
+	want := []api.EnvVar{
+		{Name: "DATABASE_URL", Value: "postgres://localhost/app"},
+		{Name: "DEBUG", Value: "1"},
+		{Name: "MODE", Value: "a=b"},
+	}
+	if got := podSpec(t, result, "web").Containers[0].Env; !reflect.DeepEqual(got, want) {
+		t.Errorf("got env %s, want %s", toJSON(got), toJSON(want))
+	}
+}
@@ -0,0 +1,106 @@
+/*
+Copyright 2015 Kelsey Hightower All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package convert
+
+import (
+	"fmt"
+	"net"
+	"strings"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/util/intstr"
+)
+
+// externalIPLabel marks a service as an external dependency, such as a
+// managed database, reachable at a comma-separated list of IP addresses.
+const externalIPLabel = "kompose.service.external-ip"
+
+// newExternalService creates a service without a selector for an external
+// dependency, and the endpoints pointing it at the addresses in ips. Both
+// are named after rc and use its container ports.
+func newExternalService(name string, rc *api.ReplicationController, ips string) ([]Object, error) {
+	ports := rc.Spec.Template.Spec.Containers[0].Ports
+	if len(ports) == 0 {
+		return nil, fmt.Errorf("no ports to forward")
+	}
+	var addresses []api.EndpointAddress
+	for _, ip := range strings.Split(ips, ",") {
+		ip = strings.TrimSpace(ip)
+		if net.ParseIP(ip) == nil {
+			return nil, fmt.Errorf("invalid IP address %q", ip)
+		}
+		addresses = append(addresses, api.EndpointAddress{IP: ip})
+	}
+
+	service := &api.Service{
+		TypeMeta: unversioned.TypeMeta{
+			Kind:       "Service",
+			APIVersion: "v1",
+		},
+		ObjectMeta: api.ObjectMeta{
+			Name:   rc.Name,
+			Labels: rc.Labels,
+		},
+		Spec: api.ServiceSpec{
+			Type: api.ServiceTypeClusterIP,
+		},
+	}
+	var endpointPorts []api.EndpointPort
+	for _, port := range ports {
+		servicePort := api.ServicePort{
+			Protocol:   api.ProtocolTCP,
+			Port:       port.ContainerPort,
+			TargetPort: intstr.FromInt(int(port.ContainerPort)),
+		}
+		// Services with several ports must name each of them, and the
+		// endpoints match their ports by name.
+		if len(ports) > 1 {
+			servicePort.Name = fmt.Sprintf("tcp-%d", port.ContainerPort)
+		}
+		service.Spec.Ports = append(service.Spec.Ports, servicePort)
+		endpointPorts = append(endpointPorts, api.EndpointPort{
+			Name:     servicePort.Name,
+			Port:     port.ContainerPort,
+			Protocol: api.ProtocolTCP,
+		})
+	}
+
+	endpoints := &api.Endpoints{
+		TypeMeta: unversioned.TypeMeta{
+			Kind:       "Endpoints",
+			APIVersion: "v1",
+		},
+		ObjectMeta: service.ObjectMeta,
+		Subsets: []api.EndpointSubset{
+			{Addresses: addresses, Ports: endpointPorts},
+		},
+	}
+	return []Object{
+		{
+			Object:   service,
+			Service:  name,
+			Kind:     service.Kind,
+			Name:     service.Name,
+			BaseName: service.Name + "-svc",
+		},
+		{
+			Object:   endpoints,
+			Service:  name,
+			Kind:     endpoints.Kind,
+			Name:     endpoints.Name,
+			BaseName: endpoints.Name + "-endpoints",
+		},
+	}, nil
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2015 Kelsey Hightower All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package convert
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/util/intstr"
+)
+
+func TestExternalService(t *testing.T) {
+	result := convertProject(t, `version: "2"
+services:
+  db:
+    image: postgres
+    ports:
+      - "5432"
+    labels:
+      kompose.service.external-ip: 10.0.0.5
+`, nil, Options{})
+
+	// Nothing runs in the cluster for the dependency.
+	var kinds []string
+	for _, obj := range result.Objects {
+		kinds = append(kinds, obj.Kind)
+	}
+	if want := []string{"Service", "Endpoints"}; !reflect.DeepEqual(kinds, want) {
+		t.Fatalf("got objects of kinds %q, want %q", kinds, want)
+	}
+
+	service := findObject(t, result, "Service", "db").Object.(*api.Service)
+	if service.Spec.Selector != nil {
+		t.Errorf("got selector %v, want none", service.Spec.Selector)
+	}
+	wantPorts := []api.ServicePort{{Protocol: api.ProtocolTCP, Port: 5432, TargetPort: intstr.FromInt(5432)}}
+	if !reflect.DeepEqual(service.Spec.Ports, wantPorts) {
+		t.Errorf("got service ports %s, want %s", toJSON(service.Spec.Ports), toJSON(wantPorts))
+	}
+
+	// The endpoints take the name of the service, which is how Kubernetes
+	// pairs them, and its ports.
+	endpoints := findObject(t, result, "Endpoints", "db").Object.(*api.Endpoints)
+	want := []api.EndpointSubset{{
+		Addresses: []api.EndpointAddress{{IP: "10.0.0.5"}},
+		Ports:     []api.EndpointPort{{Port: 5432, Protocol: api.ProtocolTCP}},
+	}}
+	if endpoints.Name != service.Name || !reflect.DeepEqual(endpoints.Subsets, want) {
+		t.Errorf("got endpoints %s %s, want %s %s", endpoints.Name, toJSON(endpoints.Subsets), service.Name, toJSON(want))
+	}
+
+	// With several ports, the endpoint ports match the service ports by
+	// name.
+	result = convertProject(t, `version: "2"
+services:
+  cache:
+    image: redis
+    ports:
+      - "6379"
+      - "16379"
+    labels:
+      kompose.service.external-ip: 10.0.0.6, 10.0.0.7
+`, nil, Options{})
+	service = findObject(t, result, "Service", "cache").Object.(*api.Service)
+	endpoints = findObject(t, result, "Endpoints", "cache").Object.(*api.Endpoints)
+	if len(endpoints.Subsets) != 1 || len(endpoints.Subsets[0].Addresses) != 2 || len(endpoints.Subsets[0].Ports) != len(service.Spec.Ports) {
+		t.Fatalf("got endpoints %s for service ports %s", toJSON(endpoints.Subsets), toJSON(service.Spec.Ports))
+	}
+	for i, port := range service.Spec.Ports {
+		endpointPort := endpoints.Subsets[0].Ports[i]
+		if port.Name == "" || endpointPort.Name != port.Name || endpointPort.Port != port.Port {
+			t.Errorf("got endpoint port %s for service port %s", toJSON(endpointPort), toJSON(port))
+		}
+	}
+}
+
+func TestExternalServiceErrors(t *testing.T) {
+	for _, service := range []string{
+		"ports:\n      - \"5432\"\n    labels:\n      kompose.service.external-ip: db.example.com",
+		"labels:\n      kompose.service.external-ip: 10.0.0.5",
+		"ports:\n      - \"5432\"\n    network_mode: none\n    labels:\n      kompose.service.external-ip: 10.0.0.5",
+	} {
+		_, err := Convert(writeProject(t, `version: "2"
+services:
+  db:
+    image: postgres
+    `+service+`
+`, nil), Options{})
+		if err == nil {
+			t.Errorf("converting %q succeeded, want an error", service)
+		}
+	}
+}
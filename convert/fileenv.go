@@ -0,0 +1,84 @@
+/*
+Copyright 2015 Kelsey Hightower All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package convert
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+)
+
+// How environment values read from files with ${file:path} are passed to the
+// container.
+const (
+	// EnvFromFilesInline sets the content of the file as the value.
+	EnvFromFilesInline = "inline"
+	// EnvFromFilesSecret stores the content of the file in a Secret of the
+	// service and references it from the variable.
+	EnvFromFilesSecret = "secret"
+)
+
+// fileRefPrefix starts a reference to a file in an environment value, as in
+// ${file:/run/secrets/token}.
+const fileRefPrefix = "file:"
+
+// fileEnvPath returns the path of the file an environment value is read
+// from, and false when the value does not reference a file. A reference must
+// be the whole value.
+func fileEnvPath(value string) (string, bool, error) {
+	if !strings.Contains(value, "${"+fileRefPrefix) {
+		return "", false, nil
+	}
+	if !strings.HasPrefix(value, "${"+fileRefPrefix) || strings.Index(value, "}") != len(value)-1 {
+		return "", false, fmt.Errorf("file reference in %q must be the whole value", value)
+	}
+	path := value[len("${"+fileRefPrefix) : len(value)-1]
+	if path == "" {
+		return "", false, fmt.Errorf("missing path in %q", value)
+	}
+	return path, true, nil
+}
+
+// readEnvFromFile reads an environment value from the file at path, without
+// its trailing newline. Relative paths are resolved against baseDir.
+func readEnvFromFile(path, baseDir string) (string, error) {
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(baseDir, path)
+	}
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	value := strings.TrimSuffix(string(data), "\n")
+	return strings.TrimSuffix(value, "\r"), nil
+}
+
+// newEnvSecret creates a Secret holding the environment values of a service
+// read from files, keyed by variable name.
+func newEnvSecret(name string, data map[string][]byte) *api.Secret {
+	return &api.Secret{
+		TypeMeta: unversioned.TypeMeta{
+			Kind:       "Secret",
+			APIVersion: "v1",
+		},
+		ObjectMeta: api.ObjectMeta{
+			Name: name,
+		},
+		Data: data,
+	}
+}
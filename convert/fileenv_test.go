@@ -0,0 +1,99 @@
+/*
+Copyright 2015 Kelsey Hightower All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package convert
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"k8s.io/kubernetes/pkg/api"
+)
+
+// fileEnv reads TOKEN from a file next to the compose file and keeps a
+// plain value.
+const fileEnv = `version: "2"
+services:
+  web:
+    image: nginx
+    environment:
+      - TOKEN=${file:secrets/token.txt}
+      - MODE=production
+`
+
+var fileEnvFiles = map[string]string{
+	"secrets/token.txt": "s3cr3t\n",
+}
+
+func TestEnvFromFilesInline(t *testing.T) {
+	result := convertProject(t, fileEnv, fileEnvFiles, Options{EnvFromFiles: EnvFromFilesInline})
+
+	// The relative path is resolved against the compose directory, and the
+	// value without a file reference is left alone.
+	want := []api.EnvVar{
+		{Name: "TOKEN", Value: "s3cr3t"},
+		{Name: "MODE", Value: "production"},
+	}
+	if got := podSpec(t, result, "web").Containers[0].Env; !reflect.DeepEqual(got, want) {
+		t.Errorf("got env %s, want %s", toJSON(got), toJSON(want))
+	}
+}
+
+func TestEnvFromFilesSecret(t *testing.T) {
+	result := convertProject(t, fileEnv, fileEnvFiles, Options{EnvFromFiles: EnvFromFilesSecret})
+
+	secret := findObject(t, result, "Secret", "web-env").Object.(*api.Secret)
+	if want := map[string][]byte{"TOKEN": []byte("s3cr3t")}; !reflect.DeepEqual(secret.Data, want) {
+		t.Errorf("got secret data %q, want %q", secret.Data, want)
+	}
+	want := []api.EnvVar{
+		{Name: "TOKEN", ValueFrom: &api.EnvVarSource{
+			SecretKeyRef: &api.SecretKeySelector{
+				LocalObjectReference: api.LocalObjectReference{Name: "web-env"},
+				Key:                  "TOKEN",
+			},
+		}},
+		{Name: "MODE", Value: "production"},
+	}
+	if got := podSpec(t, result, "web").Containers[0].Env; !reflect.DeepEqual(got, want) {
+		t.Errorf("got env %s, want %s", toJSON(got), toJSON(want))
+	}
+}
+
+func TestEnvFromFilesErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		files map[string]string
+		opts  Options
+		want  string
+	}{
+		{"missing file", "${file:missing.txt}", nil, Options{EnvFromFiles: EnvFromFilesInline}, "failed to read environment variable TOKEN"},
+		{"not the whole value", "Bearer ${file:token.txt}", map[string]string{"token.txt": "x"}, Options{EnvFromFiles: EnvFromFilesInline}, "must be the whole value"},
+		{"without the option", "${file:token.txt}", map[string]string{"token.txt": "x"}, Options{}, "invalid variable reference ${file:token.txt}"},
+	}
+	for _, test := range tests {
+		composeFile := writeProject(t, `version: "2"
+services:
+  web:
+    image: nginx
+    environment:
+      - TOKEN=`+test.value+`
+`, test.files)
+		_, err := Convert(composeFile, test.opts)
+		if err == nil || !strings.Contains(err.Error(), test.want) {
+			t.Errorf("%s: got error %v, want one containing %q", test.name, err, test.want)
+		}
+	}
+}
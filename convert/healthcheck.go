@@ -0,0 +1,205 @@
+/*
+Copyright 2015 Kelsey Hightower All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package convert
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/util/intstr"
+)
+
+// Prefixes of the labels configuring the liveness and readiness probes of a
+// service, such as kompose.readiness.http_get_path.
+const (
+	livenessLabelPrefix  = "kompose.liveness."
+	readinessLabelPrefix = "kompose.readiness."
+)
+
+type healthcheckConfig struct {
+	Test        healthcheckTest `yaml:"test"`
+	Interval    string          `yaml:"interval"`
+	Timeout     string          `yaml:"timeout"`
+	Retries     *int32          `yaml:"retries"`
+	StartPeriod string          `yaml:"start_period"`
+	Disable     bool            `yaml:"disable"`
+}
+
+// healthcheckTest is the test of a healthcheck. The string form is run by the
+// shell and stored as a CMD-SHELL test.
+type healthcheckTest []string
+
+// UnmarshalYAML accepts both the string and the list form.
+func (t *healthcheckTest) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var command string
+	if err := unmarshal(&command); err == nil {
+		*t = healthcheckTest{"CMD-SHELL", command}
+		return nil
+	}
+	var test []string
+	if err := unmarshal(&test); err != nil {
+		return err
+	}
+	*t = healthcheckTest(test)
+	return nil
+}
+
+// healthcheckProbe translates a healthcheck into an exec probe. It returns
+// nil when the healthcheck is disabled.
+func healthcheckProbe(healthcheck *healthcheckConfig) (*api.Probe, error) {
+	if healthcheck.Disable {
+		return nil, nil
+	}
+
+	var command []string
+	switch {
+	case len(healthcheck.Test) == 0:
+		return nil, fmt.Errorf("no test")
+	case healthcheck.Test[0] == "NONE":
+		return nil, nil
+	case healthcheck.Test[0] == "CMD" && len(healthcheck.Test) > 1:
+		command = healthcheck.Test[1:]
+	case healthcheck.Test[0] == "CMD-SHELL" && len(healthcheck.Test) == 2:
+		command = []string{"/bin/sh", "-c", healthcheck.Test[1]}
+	case healthcheck.Test[0] != "CMD" && healthcheck.Test[0] != "CMD-SHELL":
+		// Docker runs a list without a keyword like a CMD test.
+		command = healthcheck.Test
+	default:
+		return nil, fmt.Errorf("invalid test %q", []string(healthcheck.Test))
+	}
+
+	probe := &api.Probe{
+		Handler: api.Handler{
+			Exec: &api.ExecAction{Command: command},
+		},
+	}
+	if err := setProbeTiming(probe, healthcheck); err != nil {
+		return nil, err
+	}
+	return probe, nil
+}
+
+// setProbeTiming sets the period, timeout, failure threshold and initial
+// delay of probe from the interval, timeout, retries and start_period of a
+// healthcheck. The initial delay is 0 when start_period is not set.
+func setProbeTiming(probe *api.Probe, healthcheck *healthcheckConfig) error {
+	fields := []struct {
+		name  string
+		value string
+		field *int32
+	}{
+		{"interval", healthcheck.Interval, &probe.PeriodSeconds},
+		{"timeout", healthcheck.Timeout, &probe.TimeoutSeconds},
+		{"start_period", healthcheck.StartPeriod, &probe.InitialDelaySeconds},
+	}
+	for _, f := range fields {
+		if f.value == "" {
+			continue
+		}
+		seconds, err := durationSeconds(f.value)
+		if err != nil {
+			return fmt.Errorf("invalid %s %s", f.name, f.value)
+		}
+		*f.field = seconds
+	}
+	if healthcheck.Retries != nil {
+		if *healthcheck.Retries <= 0 {
+			return fmt.Errorf("invalid retries %d", *healthcheck.Retries)
+		}
+		probe.FailureThreshold = *healthcheck.Retries
+	}
+	return nil
+}
+
+// durationSeconds parses a compose duration such as 1m30s and rounds it up
+// to whole seconds.
+func durationSeconds(value string) (int32, error) {
+	d, err := time.ParseDuration(value)
+	if err != nil || d < 0 {
+		return 0, fmt.Errorf("invalid duration %s", value)
+	}
+	return int32((d + time.Second - 1) / time.Second), nil
+}
+
+// labelProbe creates a probe from the labels starting with prefix. The
+// labels take the timing options of a healthcheck, and one of test, run by
+// the shell, http_get_path with http_get_port, or tcp_port. It returns nil
+// when there are no such labels.
+func labelProbe(labels map[string]string, prefix string) (*api.Probe, error) {
+	if !hasLabelPrefix(labels, prefix) {
+		return nil, nil
+	}
+
+	probe := &api.Probe{}
+	timing := &healthcheckConfig{}
+	handlers := 0
+	for key, value := range labels {
+		if !strings.HasPrefix(key, prefix) {
+			continue
+		}
+		switch option := strings.TrimPrefix(key, prefix); option {
+		case "test":
+			probe.Exec = &api.ExecAction{Command: []string{"/bin/sh", "-c", value}}
+			handlers++
+		case "http_get_path":
+			port, ok := labels[prefix+"http_get_port"]
+			if !ok {
+				return nil, fmt.Errorf("%s requires %shttp_get_port", key, prefix)
+			}
+			probe.HTTPGet = &api.HTTPGetAction{Path: value, Port: probePort(port)}
+			handlers++
+		case "http_get_port":
+			if _, ok := labels[prefix+"http_get_path"]; !ok {
+				return nil, fmt.Errorf("%s requires %shttp_get_path", key, prefix)
+			}
+		case "tcp_port":
+			probe.TCPSocket = &api.TCPSocketAction{Port: probePort(value)}
+			handlers++
+		case "interval":
+			timing.Interval = value
+		case "timeout":
+			timing.Timeout = value
+		case "start_period":
+			timing.StartPeriod = value
+		case "retries":
+			retries, err := strconv.ParseInt(value, 10, 32)
+			if err != nil {
+				return nil, fmt.Errorf("%s %q is not an integer", key, value)
+			}
+			r := int32(retries)
+			timing.Retries = &r
+		default:
+			return nil, fmt.Errorf("unknown label %s", key)
+		}
+	}
+	if handlers != 1 {
+		return nil, fmt.Errorf("exactly one of %[1]stest, %[1]shttp_get_path and %[1]stcp_port is required", prefix)
+	}
+	if err := setProbeTiming(probe, timing); err != nil {
+		return nil, err
+	}
+	return probe, nil
+}
+
+// probePort parses a probe port, which is either a number or the name of a
+// container port.
+func probePort(value string) intstr.IntOrString {
+	if port, err := strconv.Atoi(value); err == nil {
+		return intstr.FromInt(port)
+	}
+	return intstr.FromString(value)
+}
@@ -0,0 +1,225 @@
+/*
+Copyright 2015 Kelsey Hightower All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package convert
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/kubernetes/pkg/util/intstr"
+)
+
+func TestHealthcheckTest(t *testing.T) {
+	tests := []struct {
+		healthcheck string
+		want        []string
+	}{
+		// Docker runs a list without a keyword like a CMD test.
+		{`test: ["curl", "-f", "http://localhost"]`, []string{"curl", "-f", "http://localhost"}},
+		{`test: ["CMD", "curl", "-f", "http://localhost"]`, []string{"curl", "-f", "http://localhost"}},
+		{`test: ["CMD-SHELL", "curl -f http://localhost"]`, []string{"/bin/sh", "-c", "curl -f http://localhost"}},
+		{`test: curl -f http://localhost`, []string{"/bin/sh", "-c", "curl -f http://localhost"}},
+		{`test: ["NONE"]`, nil},
+		{`disable: true`, nil},
+	}
+	for _, test := range tests {
+		result := convertProject(t, `version: "2.1"
+services:
+  web:
+    image: nginx
+    healthcheck:
+      `+test.healthcheck+`
+`, nil, Options{})
+		probe := podSpec(t, result, "web").Containers[0].LivenessProbe
+		if test.want == nil {
+			if probe != nil {
+				t.Errorf("healthcheck %s: got probe %s, want none", test.healthcheck, toJSON(probe))
+			}
+			continue
+		}
+		if probe == nil || probe.Exec == nil || !reflect.DeepEqual(probe.Exec.Command, test.want) {
+			t.Errorf("healthcheck %s: got probe %s, want command %q", test.healthcheck, toJSON(probe), test.want)
+		}
+	}
+
+	for _, healthcheck := range []string{`test: ["CMD"]`, `test: ["CMD-SHELL", "a", "b"]`, `test: []`} {
+		_, err := Convert(writeProject(t, `version: "2.1"
+services:
+  web:
+    image: nginx
+    healthcheck:
+      `+healthcheck+`
+`, nil), Options{})
+		if err == nil {
+			t.Errorf("converting healthcheck %s succeeded, want an error", healthcheck)
+		}
+	}
+}
+
+func TestHealthcheckStartPeriod(t *testing.T) {
+	tests := []struct {
+		timing string
+		want   int32
+	}{
+		{"", 0},
+		{"start_period: 40s", 40},
+		{"start_period: 1m30s", 90},
+		{"start_period: 1500ms", 2},
+	}
+	for _, test := range tests {
+		result := convertProject(t, `version: "3.4"
+services:
+  web:
+    image: nginx
+    healthcheck:
+      test: ["CMD", "true"]
+      `+test.timing+`
+`, nil, Options{})
+		probe := podSpec(t, result, "web").Containers[0].LivenessProbe
+		if probe == nil || probe.InitialDelaySeconds != test.want {
+			t.Errorf("healthcheck %q: got probe %s, want an initial delay of %d seconds", test.timing, toJSON(probe), test.want)
+		}
+	}
+
+	_, err := Convert(writeProject(t, `version: "3.4"
+services:
+  web:
+    image: nginx
+    healthcheck:
+      test: ["CMD", "true"]
+      start_period: soon
+`, nil), Options{})
+	if err == nil {
+		t.Error("converting start_period soon succeeded, want an error")
+	}
+}
+
+func TestAutoProbe(t *testing.T) {
+	const compose = `version: "2"
+services:
+  single:
+    image: nginx
+    ports:
+      - "8080:80"
+  multiple:
+    image: nginx
+    ports:
+      - "80"
+      - "443"
+  none:
+    image: worker
+  labelled:
+    image: nginx
+    ports:
+      - "80"
+    labels:
+      kompose.readiness.tcp_port: "8081"
+`
+	result := convertProject(t, compose, nil, Options{AutoProbe: true})
+
+	probe := podSpec(t, result, "single").Containers[0].ReadinessProbe
+	if probe == nil || probe.TCPSocket == nil || probe.TCPSocket.Port != intstr.FromInt(80) {
+		t.Errorf("got readiness probe %s, want a TCP probe of port 80", toJSON(probe))
+	}
+
+	// Without a single port there is no port to pick, and an explicit probe
+	// wins.
+	for _, name := range []string{"multiple", "none"} {
+		if probe := podSpec(t, result, name).Containers[0].ReadinessProbe; probe != nil {
+			t.Errorf("%s: got readiness probe %s, want none", name, toJSON(probe))
+		}
+	}
+	probe = podSpec(t, result, "labelled").Containers[0].ReadinessProbe
+	if probe == nil || probe.TCPSocket == nil || probe.TCPSocket.Port != intstr.FromInt(8081) {
+		t.Errorf("got readiness probe %s, want the TCP probe of the label", toJSON(probe))
+	}
+
+	result = convertProject(t, compose, nil, Options{})
+	if probe := podSpec(t, result, "single").Containers[0].ReadinessProbe; probe != nil {
+		t.Errorf("got readiness probe %s without -auto-probe, want none", toJSON(probe))
+	}
+}
+
+func TestLabelProbes(t *testing.T) {
+	result := convertProject(t, `version: "2.1"
+services:
+  web:
+    image: nginx
+    healthcheck:
+      test: ["CMD", "true"]
+    labels:
+      kompose.readiness.http_get_path: /ready
+      kompose.readiness.http_get_port: "8080"
+      kompose.readiness.interval: 5s
+      kompose.readiness.retries: "2"
+      kompose.liveness.test: pgrep nginx
+      kompose.liveness.start_period: 30s
+`, nil, Options{})
+
+	container := podSpec(t, result, "web").Containers[0]
+	readiness := container.ReadinessProbe
+	if readiness == nil || readiness.HTTPGet == nil {
+		t.Fatalf("got readiness probe %s, want an HTTP probe", toJSON(readiness))
+	}
+	if readiness.HTTPGet.Path != "/ready" || readiness.HTTPGet.Port != intstr.FromInt(8080) {
+		t.Errorf("got HTTP probe %s, want /ready on port 8080", toJSON(readiness.HTTPGet))
+	}
+	if readiness.PeriodSeconds != 5 || readiness.FailureThreshold != 2 {
+		t.Errorf("got period %d and failure threshold %d, want 5 and 2", readiness.PeriodSeconds, readiness.FailureThreshold)
+	}
+
+	// The liveness labels override the healthcheck.
+	liveness := container.LivenessProbe
+	want := []string{"/bin/sh", "-c", "pgrep nginx"}
+	if liveness == nil || liveness.Exec == nil || !reflect.DeepEqual(liveness.Exec.Command, want) || liveness.InitialDelaySeconds != 30 {
+		t.Errorf("got liveness probe %s, want %q after 30 seconds", toJSON(liveness), want)
+	}
+
+	// A named port refers to a container port.
+	result = convertProject(t, `version: "2"
+services:
+  web:
+    image: nginx
+    labels:
+      kompose.readiness.http_get_path: /healthz
+      kompose.readiness.http_get_port: http
+`, nil, Options{})
+	readiness = podSpec(t, result, "web").Containers[0].ReadinessProbe
+	if readiness == nil || readiness.HTTPGet == nil || readiness.HTTPGet.Port != intstr.FromString("http") {
+		t.Errorf("got readiness probe %s, want the http port", toJSON(readiness))
+	}
+}
+
+func TestLabelProbesInvalid(t *testing.T) {
+	for _, labels := range []string{
+		"kompose.readiness.http_get_path: /ready",
+		"kompose.readiness.http_get_port: \"8080\"",
+		"kompose.readiness.http_get_path: /ready\n      kompose.readiness.http_get_port: \"8080\"\n      kompose.readiness.tcp_port: \"8080\"",
+		"kompose.readiness.interval: 5s",
+		"kompose.readiness.tcp_port: \"8080\"\n      kompose.readiness.retries: many",
+		"kompose.readiness.tcp_port: \"8080\"\n      kompose.readiness.timeout: soon",
+		"kompose.liveness.exec: \"true\"",
+	} {
+		_, err := Convert(writeProject(t, `version: "2"
+services:
+  web:
+    image: nginx
+    labels:
+      `+labels+`
+`, nil), Options{})
+		if err == nil {
+			t.Errorf("converting %q succeeded, want an error", labels)
+		}
+	}
+}
@@ -0,0 +1,231 @@
+/*
+Copyright 2015 Kelsey Hightower All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package convert
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// interpolateValue substitutes variables in every string within a decoded
+// YAML value. Map keys are left untouched.
+func (c *converter) interpolateValue(value interface{}) (interface{}, error) {
+	switch v := value.(type) {
+	case string:
+		return c.interpolate(v)
+	case []interface{}:
+		for i := range v {
+			item, err := c.interpolateValue(v[i])
+			if err != nil {
+				return nil, err
+			}
+			v[i] = item
+		}
+	case map[interface{}]interface{}:
+		for key, item := range v {
+			item, err := c.interpolateValue(item)
+			if err != nil {
+				return nil, fmt.Errorf("%v: %v", key, err)
+			}
+			v[key] = item
+		}
+	}
+	return value, nil
+}
+
+// interpolateEnvironment substitutes variables in the environment of a
+// service. In the list form each entry can reference the variables defined by
+// the entries before it, which take precedence over the process environment.
+// The map form has no order, so its values only see the process environment.
+func (c *converter) interpolateEnvironment(env interface{}) (interface{}, error) {
+	// File references are resolved once the service is converted.
+	c.fileRefs = c.opts.EnvFromFiles != ""
+	defer func() { c.fileRefs = false }()
+
+	entries, ok := env.([]interface{})
+	if !ok {
+		return c.interpolateValue(env)
+	}
+
+	c.scope = make(map[string]string)
+	defer func() { c.scope = nil }()
+	for i, entry := range entries {
+		s, ok := entry.(string)
+		if !ok {
+			continue
+		}
+		s, err := c.interpolate(s)
+		if err != nil {
+			return nil, err
+		}
+		entries[i] = s
+		if parts := strings.SplitN(s, "=", 2); len(parts) == 2 {
+			c.scope[parts[0]] = strings.Replace(parts[1], "$$", "$", -1)
+		}
+	}
+	return entries, nil
+}
+
+// interpolate substitutes $VAR and ${VAR} references in s with the value of
+// the environment variables. The braced form also accepts the compose
+// default and alternate value forms:
+//
+//	${VAR:-default}  default when VAR is unset or empty
+//	${VAR-default}   default when VAR is unset
+//	${VAR:+alt}      alt when VAR is set and not empty
+//	${VAR+alt}       alt when VAR is set
+//	${VAR:?message}  error with message when VAR is unset or empty
+//	${VAR?message}   error with message when VAR is unset
+//
+// Referencing an unset variable without a default is an error unless
+// AllowUnset is set, in which case a warning is logged and the reference
+// is replaced with an empty string. In environments converted with
+// EnvFromFiles, ${file:path} references are kept as they are.
+//
+// libcompose interpolates the result again, so every $ of the substituted
+// values is escaped as $$, like the escaped $$ already in s.
+func (c *converter) interpolate(s string) (string, error) {
+	var buf bytes.Buffer
+	for i := 0; i < len(s); i++ {
+		if s[i] != '$' {
+			buf.WriteByte(s[i])
+			continue
+		}
+		if i+1 < len(s) && s[i+1] == '$' {
+			buf.WriteString("$$")
+			i++
+			continue
+		}
+
+		var expr string
+		switch {
+		case i+1 < len(s) && s[i+1] == '{':
+			end := strings.IndexByte(s[i+2:], '}')
+			if end < 0 {
+				return "", fmt.Errorf("unterminated variable reference in %q", s)
+			}
+			expr = s[i+2 : i+2+end]
+			i += end + 2
+			if c.fileRefs && strings.HasPrefix(expr, fileRefPrefix) {
+				buf.WriteString("$${" + expr + "}")
+				continue
+			}
+		default:
+			end := i + 1
+			for end < len(s) && isNameChar(s[end], end == i+1) {
+				end++
+			}
+			if end == i+1 {
+				buf.WriteByte('$')
+				continue
+			}
+			expr = s[i+1 : end]
+			i = end - 1
+		}
+
+		value, err := c.expand(expr)
+		if err != nil {
+			return "", err
+		}
+		buf.WriteString(strings.Replace(value, "$", "$$", -1))
+	}
+	return buf.String(), nil
+}
+
+// expand resolves the inside of a single variable reference.
+func (c *converter) expand(expr string) (string, error) {
+	end := 0
+	for end < len(expr) && isNameChar(expr[end], end == 0) {
+		end++
+	}
+	name, rest := expr[:end], expr[end:]
+	if name == "" {
+		return "", fmt.Errorf("invalid variable reference ${%s}", expr)
+	}
+	value, set := c.scope[name]
+	if !set {
+		value, set = c.lookupEnv(name)
+	}
+
+	if rest == "" {
+		if !set {
+			if !c.opts.AllowUnset {
+				return "", fmt.Errorf("variable %s is not set and has no default", name)
+			}
+			c.report.warnf("Variable %s is not set, substituting an empty string", name)
+		}
+		return value, nil
+	}
+
+	// The colon forms treat an empty value the same as an unset one.
+	present := set
+	if rest[0] == ':' {
+		present = set && value != ""
+		rest = rest[1:]
+	}
+	if rest == "" {
+		return "", fmt.Errorf("invalid variable reference ${%s}", expr)
+	}
+	switch op, word := rest[0], rest[1:]; op {
+	case '-':
+		if present {
+			return value, nil
+		}
+		return word, nil
+	case '+':
+		if present {
+			return word, nil
+		}
+		return "", nil
+	case '?':
+		if present {
+			return value, nil
+		}
+		if word == "" {
+			word = "is not set"
+		}
+		return "", fmt.Errorf("variable %s %s", name, word)
+	}
+	return "", fmt.Errorf("invalid variable reference ${%s}", expr)
+}
+
+func isNameChar(c byte, first bool) bool {
+	switch {
+	case c == '_', c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z':
+		return true
+	case c >= '0' && c <= '9':
+		return !first
+	}
+	return false
+}
+
+// unescapeDollars replaces the $$ escapes of interpolated strings within a
+// decoded YAML value with $, for the options that do not go through
+// libcompose, which unescapes the others.
+func unescapeDollars(value interface{}) interface{} {
+	switch v := value.(type) {
+	case string:
+		return strings.Replace(v, "$$", "$", -1)
+	case []interface{}:
+		for i := range v {
+			v[i] = unescapeDollars(v[i])
+		}
+	case map[interface{}]interface{}:
+		for key, item := range v {
+			v[key] = unescapeDollars(item)
+		}
+	}
+	return value
+}
@@ -0,0 +1,170 @@
+/*
+Copyright 2015 Kelsey Hightower All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package convert
+
+import (
+	"reflect"
+	"testing"
+)
+
+// testEnv is the environment the interpolation tests look variables up in.
+var testEnv = map[string]string{
+	"TAG":   "1.2",
+	"EMPTY": "",
+	"PW":    "p$ss",
+}
+
+func newTestConverter(opts Options) *converter {
+	return &converter{
+		opts: opts,
+		lookupEnv: func(name string) (string, bool) {
+			value, ok := testEnv[name]
+			return value, ok
+		},
+		report: newReport(),
+	}
+}
+
+func TestInterpolate(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		// The four combinations of a set or unset variable with and
+		// without a default.
+		{"nginx:${TAG}", "nginx:1.2"},
+		{"nginx:${TAG:-latest}", "nginx:1.2"},
+		{"nginx:${UNSET:-latest}", "nginx:latest"},
+
+		{"nginx:$TAG", "nginx:1.2"},
+		{"nginx:${EMPTY:-latest}", "nginx:latest"},
+		{"nginx:${EMPTY-latest}", "nginx:"},
+		{"nginx:${UNSET-latest}", "nginx:latest"},
+		{"${TAG:+tagged}", "tagged"},
+		{"${EMPTY:+tagged}", ""},
+		{"${EMPTY+tagged}", "tagged"},
+		{"${UNSET+tagged}", ""},
+		{"${TAG:?must be set}", "1.2"},
+		{"$$TAG", "$$TAG"},
+		{"cost: 5$", "cost: 5$"},
+		// libcompose interpolates the result again, so the substituted
+		// values are escaped.
+		{"PASS=${PW}", "PASS=p$$ss"},
+		{"PASS=$PW", "PASS=p$$ss"},
+		{"${UNSET:-a$b}", "a$$b"},
+	}
+	for _, test := range tests {
+		got, err := newTestConverter(Options{}).interpolate(test.in)
+		if err != nil {
+			t.Errorf("interpolate(%q): %v", test.in, err)
+			continue
+		}
+		if got != test.want {
+			t.Errorf("interpolate(%q) = %q, want %q", test.in, got, test.want)
+		}
+	}
+}
+
+func TestInterpolateUnset(t *testing.T) {
+	// An unset variable without a default is an error.
+	if got, err := newTestConverter(Options{}).interpolate("nginx:${UNSET}"); err == nil {
+		t.Errorf("interpolate of an unset variable = %q, want an error", got)
+	}
+
+	// With AllowUnset it is replaced with an empty string and a warning.
+	c := newTestConverter(Options{AllowUnset: true})
+	got, err := c.interpolate("nginx:${UNSET}")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != "nginx:" {
+		t.Errorf("interpolate of an unset variable = %q, want %q", got, "nginx:")
+	}
+	if len(c.report.Warnings) != 1 {
+		t.Errorf("got warnings %q, want one warning", c.report.Warnings)
+	}
+}
+
+func TestInterpolateErrors(t *testing.T) {
+	tests := []string{
+		"${UNSET:?must be set}",
+		"${EMPTY:?must be set}",
+		"${UNSET?must be set}",
+		"${TAG",
+		"${}",
+		"${TAG:}",
+		"${TAG*x}",
+	}
+	for _, in := range tests {
+		c := newTestConverter(Options{AllowUnset: true})
+		if got, err := c.interpolate(in); err == nil {
+			t.Errorf("interpolate(%q) = %q, want an error", in, got)
+		}
+	}
+}
+
+func TestInterpolateEnvironment(t *testing.T) {
+	// Entries of the list form see the entries before them.
+	env, err := newTestConverter(Options{}).interpolateEnvironment([]interface{}{
+		"TAG=2.0",
+		"IMAGE=nginx:${TAG}",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := env.([]interface{})[1]; got != "IMAGE=nginx:2.0" {
+		t.Errorf("got %q, want IMAGE=nginx:2.0", got)
+	}
+}
+
+func TestInterpolateEnvironmentDollar(t *testing.T) {
+	// An entry referencing an earlier one sees its value unescaped, and
+	// escapes it again.
+	env, err := newTestConverter(Options{}).interpolateEnvironment([]interface{}{
+		"PASS=${PW}",
+		"COPY=${PASS}",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := env.([]interface{})[1]; got != "COPY=p$$ss" {
+		t.Errorf("got %q, want COPY=p$$ss", got)
+	}
+}
+
+func TestDollarValues(t *testing.T) {
+	t.Setenv("PW", "p$ss")
+	result := convertProject(t, `version: "2"
+services:
+  web:
+    image: nginx
+    command: echo $$HOME ${PW}
+    environment:
+      PASS: ${PW}
+      LITERAL: $$HOME
+`, nil, Options{})
+
+	container := podSpec(t, result, "web").Containers[0]
+	env := make(map[string]string)
+	for _, e := range container.Env {
+		env[e.Name] = e.Value
+	}
+	if env["PASS"] != "p$ss" || env["LITERAL"] != "$HOME" {
+		t.Errorf("got environment %v, want PASS=p$ss and LITERAL=$HOME", env)
+	}
+	want := []string{"/bin/sh", "-c", "echo $HOME p$ss"}
+	if got := append(container.Command, container.Args...); !reflect.DeepEqual(got, want) {
+		t.Errorf("got command %q, want %q", got, want)
+	}
+}
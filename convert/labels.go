@@ -0,0 +1,64 @@
+/*
+Copyright 2015 Kelsey Hightower All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package convert
+
+import (
+	"sort"
+	"strings"
+
+	"k8s.io/kubernetes/pkg/util/validation"
+)
+
+// komposeLabelPrefix is the prefix of the labels configuring the conversion,
+// which are not carried over to the pods.
+const komposeLabelPrefix = "kompose."
+
+// composeLabel is a compose label that is not a valid Kubernetes label, with
+// the reasons why.
+type composeLabel struct {
+	Key    string
+	Errors []string
+}
+
+// splitLabels splits the compose labels of a service, other than the kompose.*
+// ones, into the labels and annotations of its pods. Labels whose value is
+// not a valid label value, such as values longer than 63 characters, are
+// demoted to annotations, and labels whose key is not valid are dropped. Both
+// are returned in key order.
+func splitLabels(labels map[string]string) (podLabels, podAnnotations map[string]string, demoted, invalid []composeLabel) {
+	keys := make([]string, 0, len(labels))
+	for key := range labels {
+		if !strings.HasPrefix(key, komposeLabelPrefix) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	podLabels = make(map[string]string)
+	podAnnotations = make(map[string]string)
+	for _, key := range keys {
+		if errs := validation.IsQualifiedName(key); len(errs) > 0 {
+			invalid = append(invalid, composeLabel{Key: key, Errors: errs})
+			continue
+		}
+		value := labels[key]
+		if errs := validation.IsValidLabelValue(value); len(errs) > 0 {
+			podAnnotations[key] = value
+			demoted = append(demoted, composeLabel{Key: key, Errors: errs})
+			continue
+		}
+		podLabels[key] = value
+	}
+	return podLabels, podAnnotations, demoted, invalid
+}
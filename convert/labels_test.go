@@ -0,0 +1,106 @@
+/*
+Copyright 2015 Kelsey Hightower All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package convert
+
+import (
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"k8s.io/kubernetes/pkg/api"
+)
+
+func TestPodLabels(t *testing.T) {
+	long := strings.Repeat("x", 64)
+	result := convertProject(t, `version: "2"
+services:
+  web:
+    image: nginx
+    labels:
+      tier: frontend
+      com.example/team: web
+      description: the public web site
+      checksum: `+long+`
+      "bad key!": value
+`, nil, Options{})
+
+	template := findObject(t, result, "ReplicationController", "web").Object.(*api.ReplicationController).Spec.Template
+	wantLabels := map[string]string{
+		"service":          "web",
+		"tier":             "frontend",
+		"com.example/team": "web",
+	}
+	if !reflect.DeepEqual(template.Labels, wantLabels) {
+		t.Errorf("got pod labels %v, want %v", template.Labels, wantLabels)
+	}
+
+	// Values that cannot be label values, with spaces or over 63
+	// characters, are kept as annotations. Invalid keys are dropped.
+	for key, value := range map[string]string{"description": "the public web site", "checksum": long} {
+		if got := template.Annotations[key]; got != value {
+			t.Errorf("got annotation %s %q, want %q", key, got, value)
+		}
+	}
+	if _, ok := template.Annotations["bad key!"]; ok {
+		t.Errorf("got an annotation for the invalid key")
+	}
+	report := result.Report.Services["web"]
+	if len(report.Warnings) != 3 || !reflect.DeepEqual(report.Skipped, []string{"labels"}) {
+		t.Errorf("got warnings %q and skipped options %q, want two demoted labels and one invalid key", report.Warnings, report.Skipped)
+	}
+}
+
+func TestListLabels(t *testing.T) {
+	// An override file with the list form merges with the labels of the
+	// base file by key.
+	composeFile := writeProject(t, `version: "2"
+services:
+  web:
+    image: nginx
+    labels:
+      - tier=backend
+      - team=web
+      - tier=frontend
+      - canary
+      - " spaced = value"
+`, map[string]string{"docker-compose.override.yml": `version: "2"
+services:
+  web:
+    labels:
+      - team=platform
+`})
+	result, err := Convert(filepath.Dir(composeFile), Options{})
+	if err != nil {
+		t.Fatalf("Convert: %v", err)
+	}
+
+	// A later entry overrides an earlier one, a bare key has an empty
+	// value, and the key is trimmed.
+	want := map[string]string{
+		"service": "web",
+		"tier":    "frontend",
+		"team":    "platform",
+		"canary":  "",
+	}
+	template := findObject(t, result, "ReplicationController", "web").Object.(*api.ReplicationController).Spec.Template
+	for key, value := range want {
+		if got, ok := template.Labels[key]; !ok || got != value {
+			t.Errorf("got label %s %q, want %q", key, got, value)
+		}
+	}
+	if got := template.Annotations["spaced"]; got != " value" {
+		t.Errorf("got annotation spaced %q, want %q", got, " value")
+	}
+}
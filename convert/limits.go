@@ -0,0 +1,83 @@
+/*
+Copyright 2015 Kelsey Hightower All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package convert
+
+import (
+	"fmt"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+)
+
+// newLimitRange creates a limit range bounding the resources of the
+// containers of the namespace. The default resources become the defaults of
+// the containers that set none, and max their upper bound.
+func newLimitRange(name string, defaults api.ResourceRequirements, max api.ResourceList) (*api.LimitRange, error) {
+	if len(defaults.Limits) == 0 && len(defaults.Requests) == 0 && len(max) == 0 {
+		return nil, fmt.Errorf("no default or maximum resources to set")
+	}
+	for r, request := range defaults.Requests {
+		if limit, ok := defaults.Limits[r]; ok && request.Cmp(limit) > 0 {
+			return nil, fmt.Errorf("the default %s request %s is above the default limit %s", r, request.String(), limit.String())
+		}
+	}
+	for r, bound := range max {
+		if limit, ok := defaults.Limits[r]; ok && limit.Cmp(bound) > 0 {
+			return nil, fmt.Errorf("the default %s limit %s is above the maximum %s", r, limit.String(), bound.String())
+		}
+		if request, ok := defaults.Requests[r]; ok && request.Cmp(bound) > 0 {
+			return nil, fmt.Errorf("the default %s request %s is above the maximum %s", r, request.String(), bound.String())
+		}
+	}
+
+	return &api.LimitRange{
+		TypeMeta: unversioned.TypeMeta{
+			Kind:       "LimitRange",
+			APIVersion: "v1",
+		},
+		ObjectMeta: api.ObjectMeta{
+			Name: name,
+		},
+		Spec: api.LimitRangeSpec{
+			Limits: []api.LimitRangeItem{
+				{
+					Type:           api.LimitTypeContainer,
+					Max:            max,
+					Default:        defaults.Limits,
+					DefaultRequest: defaults.Requests,
+				},
+			},
+		},
+	}, nil
+}
+
+// newResourceQuota creates a resource quota capping the total resources
+// requested in the namespace at hard.
+func newResourceQuota(name string, hard api.ResourceList) (*api.ResourceQuota, error) {
+	if pods, ok := hard[api.ResourcePods]; ok && pods.MilliValue()%1000 != 0 {
+		return nil, fmt.Errorf("the number of pods %s is not a whole number", pods.String())
+	}
+	return &api.ResourceQuota{
+		TypeMeta: unversioned.TypeMeta{
+			Kind:       "ResourceQuota",
+			APIVersion: "v1",
+		},
+		ObjectMeta: api.ObjectMeta{
+			Name: name,
+		},
+		Spec: api.ResourceQuotaSpec{
+			Hard: hard,
+		},
+	}, nil
+}
@@ -0,0 +1,144 @@
+/*
+Copyright 2015 Kelsey Hightower All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package convert
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/docker/libcompose/config"
+	"k8s.io/kubernetes/pkg/api"
+)
+
+// linkEnvs returns the environment variables Docker defines for the links of
+// a container, for each dependency of the named service. Dependencies come
+// from links, using the link alias, and from depends_on. The addresses are
+// the names of the services of the dependencies, with the name prefix of the
+// conversion. Variables already present in existing are left out so the
+// service definition wins.
+//
+// For a dependency db listening on port 5432 the variables are:
+//
+//	DB_NAME=/web/db
+//	DB_PORT=tcp://db:5432
+//	DB_PORT_5432_TCP=tcp://db:5432
+//	DB_PORT_5432_TCP_ADDR=db
+//	DB_PORT_5432_TCP_PORT=5432
+//	DB_PORT_5432_TCP_PROTO=tcp
+func linkEnvs(configs *config.ServiceConfigs, name, prefix string, existing []api.EnvVar) ([]api.EnvVar, error) {
+	service, _ := configs.Get(name)
+
+	aliases := make(map[string]string)
+	for _, link := range service.Links {
+		parts := strings.SplitN(link, ":", 2)
+		alias := parts[0]
+		if len(parts) == 2 {
+			alias = parts[1]
+		}
+		aliases[alias] = parts[0]
+	}
+	for _, dep := range service.DependsOn {
+		if _, ok := aliases[dep]; !ok {
+			aliases[dep] = dep
+		}
+	}
+	sorted := make([]string, 0, len(aliases))
+	for alias := range aliases {
+		sorted = append(sorted, alias)
+	}
+	sort.Strings(sorted)
+
+	defined := make(map[string]bool, len(existing))
+	for _, env := range existing {
+		defined[env.Name] = true
+	}
+	var envs []api.EnvVar
+	add := func(name, value string) {
+		if !defined[name] {
+			envs = append(envs, api.EnvVar{Name: name, Value: value})
+		}
+	}
+
+	for _, alias := range sorted {
+		host := aliases[alias]
+		dep, ok := configs.Get(host)
+		if !ok {
+			return nil, fmt.Errorf("service %s links to unknown service %s", name, host)
+		}
+		var ports []int
+		for _, port := range dep.Ports {
+			portNumber, err := containerPort(port)
+			if err != nil {
+				return nil, fmt.Errorf("invalid container port %s for service %s", port, host)
+			}
+			ports = append(ports, int(portNumber))
+		}
+		sort.Ints(ports)
+
+		envPrefix := linkEnvName(alias)
+		addr := prefix + host
+		add(envPrefix+"_NAME", fmt.Sprintf("/%s/%s", name, alias))
+		for i, port := range ports {
+			url := fmt.Sprintf("tcp://%s:%d", addr, port)
+			portPrefix := fmt.Sprintf("%s_PORT_%d_TCP", envPrefix, port)
+			if i == 0 {
+				add(envPrefix+"_PORT", url)
+			}
+			add(portPrefix, url)
+			add(portPrefix+"_ADDR", addr)
+			add(portPrefix+"_PORT", fmt.Sprint(port))
+			add(portPrefix+"_PROTO", "tcp")
+		}
+	}
+	return envs, nil
+}
+
+// externalLinkEnvs returns a variable for each of the external links of a
+// service, naming the container outside the project that the alias refers
+// to, such as DB_HOST=legacy_db_1 for legacy_db_1:db. Variables already
+// present in existing are left out so the service definition wins.
+func externalLinkEnvs(links []string, existing []api.EnvVar) []api.EnvVar {
+	defined := make(map[string]bool, len(existing))
+	for _, env := range existing {
+		defined[env.Name] = true
+	}
+	var envs []api.EnvVar
+	for _, link := range links {
+		parts := strings.SplitN(link, ":", 2)
+		alias := parts[0]
+		if len(parts) == 2 {
+			alias = parts[1]
+		}
+		name := linkEnvName(alias) + "_HOST"
+		if !defined[name] {
+			envs = append(envs, api.EnvVar{Name: name, Value: parts[0]})
+			defined[name] = true
+		}
+	}
+	return envs
+}
+
+// linkEnvName converts a link alias into an environment variable prefix.
+func linkEnvName(alias string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z':
+			return r - 'a' + 'A'
+		case r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+			return r
+		}
+		return '_'
+	}, alias)
+}
@@ -0,0 +1,56 @@
+/*
+Copyright 2015 Kelsey Hightower All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package convert
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/kubernetes/pkg/api"
+)
+
+func TestLinkEnvs(t *testing.T) {
+	result := convertProject(t, `version: "2"
+services:
+  web:
+    image: nginx
+    links:
+      - db:database
+    depends_on:
+      - cache
+    environment:
+      DATABASE_NAME: app
+  db:
+    image: postgres
+    ports:
+      - "5432"
+  cache:
+    image: redis
+`, nil, Options{EmitLinkEnv: true, NamePrefix: "shop-"})
+
+	// The addresses are the prefixed names of the services, while the
+	// variables keep the link aliases. The variables set by the service win.
+	want := []api.EnvVar{
+		{Name: "DATABASE_NAME", Value: "app"},
+		{Name: "CACHE_NAME", Value: "/web/cache"},
+		{Name: "DATABASE_PORT", Value: "tcp://shop-db:5432"},
+		{Name: "DATABASE_PORT_5432_TCP", Value: "tcp://shop-db:5432"},
+		{Name: "DATABASE_PORT_5432_TCP_ADDR", Value: "shop-db"},
+		{Name: "DATABASE_PORT_5432_TCP_PORT", Value: "5432"},
+		{Name: "DATABASE_PORT_5432_TCP_PROTO", Value: "tcp"},
+	}
+	if got := podSpec(t, result, "shop-web").Containers[0].Env; !reflect.DeepEqual(got, want) {
+		t.Errorf("got env %s, want %s", toJSON(got), toJSON(want))
+	}
+}
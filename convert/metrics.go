@@ -0,0 +1,103 @@
+/*
+Copyright 2015 Kelsey Hightower All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package convert
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/util/intstr"
+)
+
+// Labels asking for the metrics of a service to be scraped by the Prometheus
+// Operator.
+const (
+	metricsPortLabel = "kompose.metrics.port"
+	metricsPathLabel = "kompose.metrics.path"
+)
+
+// defaultMetricsPath is the path metrics are scraped from without the
+// kompose.metrics.path label.
+const defaultMetricsPath = "/metrics"
+
+// serviceMonitorGVK is the kind of the Prometheus Operator custom resource
+// describing how to scrape the pods behind a service.
+var serviceMonitorGVK = unversioned.GroupVersionKind{Group: "monitoring.coreos.com", Version: "v1", Kind: "ServiceMonitor"}
+
+// serviceMonitor is a Prometheus Operator ServiceMonitor. Only the fields
+// compose2kube sets are defined.
+type serviceMonitor struct {
+	unversioned.TypeMeta `json:",inline"`
+	api.ObjectMeta       `json:"metadata"`
+	Spec                 serviceMonitorSpec `json:"spec"`
+}
+
+type serviceMonitorSpec struct {
+	Selector  unversioned.LabelSelector `json:"selector"`
+	Endpoints []serviceMonitorEndpoint  `json:"endpoints"`
+}
+
+type serviceMonitorEndpoint struct {
+	TargetPort intstr.IntOrString `json:"targetPort"`
+	Path       string             `json:"path"`
+}
+
+// metricsPort returns the port of the kompose.metrics.port label, and false
+// when the label is not set.
+func metricsPort(labels map[string]string) (int32, bool, error) {
+	value, ok := labels[metricsPortLabel]
+	if !ok {
+		if _, ok := labels[metricsPathLabel]; ok {
+			return 0, false, fmt.Errorf("%s requires the %s label", metricsPathLabel, metricsPortLabel)
+		}
+		return 0, false, nil
+	}
+	port, err := strconv.ParseInt(value, 10, 32)
+	if err != nil || port <= 0 || port > 65535 {
+		return 0, false, fmt.Errorf("%s %q is not a port number", metricsPortLabel, value)
+	}
+	return int32(port), true, nil
+}
+
+// newServiceMonitor creates a ServiceMonitor scraping the metrics of the pods
+// behind the service with the given labels from port, at the path of the
+// kompose.metrics.path label.
+func newServiceMonitor(name string, serviceLabels map[string]string, port int32, labels map[string]string) (*serviceMonitor, error) {
+	path := defaultMetricsPath
+	if value, ok := labels[metricsPathLabel]; ok {
+		if !strings.HasPrefix(value, "/") {
+			return nil, fmt.Errorf("%s %q must start with /", metricsPathLabel, value)
+		}
+		path = value
+	}
+	return &serviceMonitor{
+		TypeMeta: unversioned.TypeMeta{
+			Kind:       serviceMonitorGVK.Kind,
+			APIVersion: serviceMonitorGVK.GroupVersion().String(),
+		},
+		ObjectMeta: api.ObjectMeta{
+			Name:   name,
+			Labels: serviceLabels,
+		},
+		Spec: serviceMonitorSpec{
+			Selector: unversioned.LabelSelector{MatchLabels: serviceLabels},
+			Endpoints: []serviceMonitorEndpoint{
+				{TargetPort: intstr.FromInt(int(port)), Path: path},
+			},
+		},
+	}, nil
+}
@@ -0,0 +1,101 @@
+/*
+Copyright 2015 Kelsey Hightower All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package convert
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/util/intstr"
+)
+
+func TestServiceMonitor(t *testing.T) {
+	result := convertProject(t, `version: "2"
+services:
+  api:
+    image: api
+    ports:
+      - "8080"
+    labels:
+      kompose.metrics.port: "9090"
+      kompose.metrics.path: /internal/metrics
+`, nil, Options{})
+
+	var kinds []string
+	for _, obj := range result.Objects {
+		kinds = append(kinds, obj.Kind)
+	}
+	if want := []string{"Service", "ServiceMonitor", "ReplicationController"}; !reflect.DeepEqual(kinds, want) {
+		t.Fatalf("got objects of kinds %q, want %q", kinds, want)
+	}
+
+	// The monitor selects the service by its labels and scrapes the metrics
+	// port of the pods.
+	service := findObject(t, result, "Service", "api").Object.(*api.Service)
+	monitor := findObject(t, result, "ServiceMonitor", "api").Object.(*serviceMonitor)
+	if monitor.APIVersion != "monitoring.coreos.com/v1" {
+		t.Errorf("got API version %s, want monitoring.coreos.com/v1", monitor.APIVersion)
+	}
+	if !reflect.DeepEqual(monitor.Spec.Selector.MatchLabels, service.Labels) {
+		t.Errorf("got selector %v, want the service labels %v", monitor.Spec.Selector.MatchLabels, service.Labels)
+	}
+	wantEndpoints := []serviceMonitorEndpoint{{TargetPort: intstr.FromInt(9090), Path: "/internal/metrics"}}
+	if !reflect.DeepEqual(monitor.Spec.Endpoints, wantEndpoints) {
+		t.Errorf("got endpoints %s, want %s", toJSON(monitor.Spec.Endpoints), toJSON(wantEndpoints))
+	}
+
+	// The metrics port is declared on the container and the service.
+	var ports []int32
+	for _, port := range podSpec(t, result, "api").Containers[0].Ports {
+		ports = append(ports, port.ContainerPort)
+	}
+	if want := []int32{8080, 9090}; !reflect.DeepEqual(ports, want) {
+		t.Errorf("got container ports %v, want %v", ports, want)
+	}
+	if len(service.Spec.Ports) != 2 || service.Spec.Ports[1].Port != 9090 {
+		t.Errorf("got service ports %s, want 8080 and 9090", toJSON(service.Spec.Ports))
+	}
+}
+
+func TestServiceMonitorInvalid(t *testing.T) {
+	for _, labels := range []string{
+		"kompose.metrics.port: \"0\"",
+		"kompose.metrics.port: http",
+		"kompose.metrics.path: /metrics",
+		"kompose.metrics.port: \"9090\"\n      kompose.metrics.path: metrics",
+	} {
+		_, err := Convert(writeProject(t, `version: "2"
+services:
+  api:
+    image: api
+    labels:
+      `+labels+`
+`, nil), Options{})
+		if err == nil {
+			t.Errorf("converting %q succeeded, want an error", labels)
+		}
+	}
+
+	_, err := Convert(writeProject(t, `version: "2"
+services:
+  api:
+    image: api
+    labels:
+      kompose.metrics.port: "9090"
+`, nil), Options{StripLabels: true})
+	if err == nil {
+		t.Error("converting a ServiceMonitor with stripped labels succeeded, want an error")
+	}
+}
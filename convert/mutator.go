@@ -0,0 +1,73 @@
+/*
+Copyright 2015 Kelsey Hightower All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package convert
+
+import (
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/meta"
+	"k8s.io/kubernetes/pkg/runtime"
+)
+
+// A Mutator modifies the objects generated by Convert before they are
+// returned. Mutators are run in the order they are registered in Options.
+type Mutator interface {
+	Mutate(obj runtime.Object) error
+}
+
+// MutatorFunc adapts an ordinary function to the Mutator interface.
+type MutatorFunc func(obj runtime.Object) error
+
+// Mutate calls f(obj).
+func (f MutatorFunc) Mutate(obj runtime.Object) error {
+	return f(obj)
+}
+
+// AnnotationMutator adds a fixed set of annotations to the metadata of every
+// object. Existing annotations with the same key are overwritten.
+type AnnotationMutator struct {
+	Annotations map[string]string
+}
+
+// Mutate adds the annotations to obj.
+func (m AnnotationMutator) Mutate(obj runtime.Object) error {
+	objectMeta, err := api.ObjectMetaFor(obj)
+	if err != nil {
+		return err
+	}
+	if objectMeta.Annotations == nil {
+		objectMeta.Annotations = make(map[string]string, len(m.Annotations))
+	}
+	for key, value := range m.Annotations {
+		objectMeta.Annotations[key] = value
+	}
+	return nil
+}
+
+// apiVersionMutator overrides the API version of the objects of the kinds in
+// versions.
+type apiVersionMutator struct {
+	versions map[string]string
+}
+
+// Mutate sets the API version of obj when its kind is overridden.
+func (m apiVersionMutator) Mutate(obj runtime.Object) error {
+	t, err := meta.TypeAccessor(obj)
+	if err != nil {
+		return err
+	}
+	if version, ok := m.versions[t.GetKind()]; ok {
+		t.SetAPIVersion(version)
+	}
+	return nil
+}
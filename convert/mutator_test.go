@@ -0,0 +1,61 @@
+/*
+Copyright 2015 Kelsey Hightower All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package convert
+
+import (
+	"testing"
+
+	"k8s.io/kubernetes/pkg/api/meta"
+)
+
+func TestAPIVersions(t *testing.T) {
+	result := convertProject(t, `version: "2"
+services:
+  web:
+    image: nginx
+    ports:
+      - "80"
+    labels:
+      kompose.service.kind: webapp
+      kompose.service.expose: shop.example.com
+`, nil, Options{APIVersions: map[string]string{
+		"Deployment": "apps/v1",
+		"Ingress":    "networking.k8s.io/v1",
+	}})
+
+	want := map[string]string{
+		"Service":    "v1",
+		"Ingress":    "networking.k8s.io/v1",
+		"Deployment": "apps/v1",
+	}
+	for _, obj := range result.Objects {
+		typeMeta, err := meta.TypeAccessor(obj.Object)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := typeMeta.GetAPIVersion(); got != want[obj.Kind] {
+			t.Errorf("got %s API version %s, want %s", obj.Kind, got, want[obj.Kind])
+		}
+	}
+
+	for _, versions := range []map[string]string{
+		{"Widget": "example.com/v1"},
+		{"Namespace": "v1"},
+		{"Deployment": ""},
+	} {
+		if _, err := Convert(writeProject(t, threeServices, nil), Options{APIVersions: versions}); err == nil {
+			t.Errorf("converting with API versions %v succeeded, want an error", versions)
+		}
+	}
+}
@@ -0,0 +1,98 @@
+/*
+Copyright 2015 Kelsey Hightower All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package convert
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/docker/libcompose/config"
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/apis/extensions"
+	"k8s.io/kubernetes/pkg/util/validation"
+)
+
+// networkLabelPrefix prefixes the pod labels marking the compose networks a
+// service is attached to, which the network policies select pods by.
+const networkLabelPrefix = "compose2kube.io/network-"
+
+// defaultNetwork is the network of the services that list no networks.
+const defaultNetwork = "default"
+
+// serviceNetworks returns the compose networks the service is attached to.
+// A service with network_mode none is attached to none.
+func serviceNetworks(service *config.ServiceConfig) []string {
+	if service.NetworkMode == "none" {
+		return nil
+	}
+	if service.Networks == nil || len(service.Networks.Networks) == 0 {
+		return []string{defaultNetwork}
+	}
+	var networks []string
+	for _, network := range service.Networks.Networks {
+		networks = append(networks, network.Name)
+	}
+	return networks
+}
+
+// networkName returns the name of the network policy of the compose network,
+// which also names the pod label of its members.
+func (c *converter) networkName(network string) (string, error) {
+	name := c.objectName(strings.Trim(invalidNameChars.ReplaceAllString(strings.ToLower(network), "-"), "-"))
+	if errs := validation.IsQualifiedName(networkLabelPrefix + name); len(errs) > 0 {
+		return "", fmt.Errorf("invalid network %s: %s", network, strings.Join(errs, ", "))
+	}
+	return name, nil
+}
+
+// newDenyPolicy creates a network policy selecting every pod of the namespace
+// and allowing no ingress traffic to them. In this API version the pods are
+// only isolated once their namespace is annotated with
+// net.beta.kubernetes.io/network-policy, which is left to the user as the
+// namespace is not generated.
+func newDenyPolicy(name string) *extensions.NetworkPolicy {
+	return &extensions.NetworkPolicy{
+		TypeMeta: unversioned.TypeMeta{
+			Kind:       "NetworkPolicy",
+			APIVersion: "extensions/v1beta1",
+		},
+		ObjectMeta: api.ObjectMeta{
+			Name: name,
+		},
+	}
+}
+
+// newNetworkPolicy creates a network policy allowing the ingress traffic
+// between the pods of the named network.
+func newNetworkPolicy(name string) *extensions.NetworkPolicy {
+	members := unversioned.LabelSelector{
+		MatchLabels: map[string]string{networkLabelPrefix + name: "true"},
+	}
+	return &extensions.NetworkPolicy{
+		TypeMeta: unversioned.TypeMeta{
+			Kind:       "NetworkPolicy",
+			APIVersion: "extensions/v1beta1",
+		},
+		ObjectMeta: api.ObjectMeta{
+			Name: name + "-network",
+		},
+		Spec: extensions.NetworkPolicySpec{
+			PodSelector: members,
+			Ingress: []extensions.NetworkPolicyIngressRule{
+				{From: []extensions.NetworkPolicyPeer{{PodSelector: &members}}},
+			},
+		},
+	}
+}
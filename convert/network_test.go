@@ -0,0 +1,92 @@
+/*
+Copyright 2015 Kelsey Hightower All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package convert
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/apis/extensions"
+)
+
+func TestDefaultDeny(t *testing.T) {
+	result := convertProject(t, `version: "2"
+services:
+  web:
+    image: nginx
+    networks:
+      - front
+  api:
+    image: api
+    networks:
+      - front
+      - back
+  db:
+    image: postgres
+    networks:
+      - back
+networks:
+  front:
+  back:
+`, nil, Options{DefaultDeny: true})
+
+	deny := findObject(t, result, "NetworkPolicy", "default-deny").Object.(*extensions.NetworkPolicy)
+	if !reflect.DeepEqual(deny.Spec, extensions.NetworkPolicySpec{}) {
+		t.Errorf("got default-deny spec %s, want an empty one", toJSON(deny.Spec))
+	}
+
+	for _, network := range []string{"front", "back"} {
+		policy := findObject(t, result, "NetworkPolicy", network+"-network").Object.(*extensions.NetworkPolicy)
+		members := map[string]string{networkLabelPrefix + network: "true"}
+		if got := policy.Spec.PodSelector.MatchLabels; !reflect.DeepEqual(got, members) {
+			t.Errorf("%s: got pod selector %v, want %v", network, got, members)
+		}
+		if len(policy.Spec.Ingress) != 1 || len(policy.Spec.Ingress[0].From) != 1 ||
+			!reflect.DeepEqual(policy.Spec.Ingress[0].From[0].PodSelector.MatchLabels, members) {
+			t.Errorf("%s: got ingress %s, want the traffic from %v", network, toJSON(policy.Spec.Ingress), members)
+		}
+	}
+
+	for _, tt := range []struct {
+		service  string
+		networks []string
+	}{
+		{"web", []string{"front"}},
+		{"api", []string{"front", "back"}},
+		{"db", []string{"back"}},
+	} {
+		labels := findObject(t, result, "ReplicationController", tt.service).Object.(*api.ReplicationController).Spec.Template.Labels
+		for _, network := range []string{"front", "back"} {
+			want := ""
+			for _, n := range tt.networks {
+				if n == network {
+					want = "true"
+				}
+			}
+			if got := labels[networkLabelPrefix+network]; got != want {
+				t.Errorf("%s: got %s label %q, want %q", tt.service, network, got, want)
+			}
+		}
+	}
+}
+
+func TestNoDefaultDeny(t *testing.T) {
+	result := convertProject(t, threeServices, nil, Options{})
+	for _, obj := range result.Objects {
+		if obj.Kind == "NetworkPolicy" {
+			t.Errorf("got network policy %s without DefaultDeny", obj.Name)
+		}
+	}
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2015 Kelsey Hightower All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package convert
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// orderAnnotation records the apply position of an object derived from the
+// depends_on graph, so GitOps tools can apply services in dependency order.
+const orderAnnotation = "compose2kube.io/order"
+
+// dependsOnAnnotation records the depends_on services of a service, in the
+// order they are declared.
+const dependsOnAnnotation = "compose2kube.io/depends-on"
+
+// dependencyOrder computes the apply position of each service from its
+// depends_on list. Services without dependencies get position 0, every other
+// service is placed one position after its deepest dependency. An error
+// naming the cycle path is returned if the dependencies are not acyclic.
+func dependencyOrder(deps map[string][]string) (map[string]int, error) {
+	names := make([]string, 0, len(deps))
+	for name := range deps {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := make(map[string]int, len(deps))
+	order := make(map[string]int, len(deps))
+	var path []string
+
+	var visit func(name string) error
+	visit = func(name string) error {
+		switch state[name] {
+		case visited:
+			return nil
+		case visiting:
+			// Trim the path down to the start of the cycle.
+			for i, n := range path {
+				if n == name {
+					cycle := append(append([]string{}, path[i:]...), name)
+					return fmt.Errorf("dependency cycle detected: %s", strings.Join(cycle, " -> "))
+				}
+			}
+		}
+
+		state[name] = visiting
+		path = append(path, name)
+		position := 0
+		for _, dep := range deps[name] {
+			if _, ok := deps[dep]; !ok {
+				return fmt.Errorf("service %s depends on unknown service %s", name, dep)
+			}
+			if err := visit(dep); err != nil {
+				return err
+			}
+			if order[dep]+1 > position {
+				position = order[dep] + 1
+			}
+		}
+		path = path[:len(path)-1]
+		state[name] = visited
+		order[name] = position
+		return nil
+	}
+
+	for _, name := range names {
+		if err := visit(name); err != nil {
+			return nil, err
+		}
+	}
+	return order, nil
+}
@@ -0,0 +1,190 @@
+/*
+Copyright 2015 Kelsey Hightower All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package convert
+
+import (
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestDependencyOrder(t *testing.T) {
+	order, err := dependencyOrder(map[string][]string{
+		"web":      {"api", "cache"},
+		"api":      {"database"},
+		"cache":    nil,
+		"database": nil,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]int{"web": 2, "api": 1, "cache": 0, "database": 0}
+	if !reflect.DeepEqual(order, want) {
+		t.Errorf("got order %v, want %v", order, want)
+	}
+}
+
+func TestDependencyOrderCycle(t *testing.T) {
+	_, err := dependencyOrder(map[string][]string{
+		"web":      {"database"},
+		"database": {"web"},
+	})
+	if err == nil || !strings.Contains(err.Error(), "database -> web -> database") {
+		t.Errorf("got error %v, want the cycle database -> web -> database", err)
+	}
+}
+
+func TestOrderAnnotation(t *testing.T) {
+	result := convertProject(t, `version: "3.3"
+services:
+  web:
+    image: nginx
+    depends_on:
+      - database
+    labels:
+      kompose.service.kind: webapp
+    ports:
+      - "80"
+  database:
+    image: postgres
+    volumes:
+      - type: volume
+        source: dbdata
+        target: /var/lib/postgresql/data
+    secrets:
+      - password
+secrets:
+  password:
+    file: ./password.txt
+volumes:
+  dbdata:
+`, map[string]string{"password.txt": "secret\n"}, Options{})
+
+	want := map[string]string{
+		"ReplicationController/database": "0",
+		"PersistentVolumeClaim/dbdata":   "0",
+		"Secret/password":                "0",
+		"Deployment/web":                 "1",
+		"Service/web":                    "1",
+	}
+	for _, obj := range result.Objects {
+		order, ok := annotations(t, obj)[orderAnnotation]
+		if !ok {
+			t.Errorf("%s %s has no order annotation", obj.Kind, obj.Name)
+			continue
+		}
+		key := obj.Kind + "/" + obj.Name
+		if w, ok := want[key]; ok && order != w {
+			t.Errorf("%s has order %s, want %s", key, order, w)
+		}
+		delete(want, key)
+	}
+	for key := range want {
+		t.Errorf("%s was not generated", key)
+	}
+}
+
+func TestDependsOnAnnotation(t *testing.T) {
+	result := convertProject(t, `version: "2"
+services:
+  web:
+    image: nginx
+    depends_on:
+      - database
+      - cache
+    labels:
+      kompose.service.kind: webapp
+    ports:
+      - "80"
+  database:
+    image: postgres
+  cache:
+    image: redis
+`, nil, Options{})
+
+	// Every object of the service lists the dependencies in the order they
+	// are declared.
+	for _, key := range [][2]string{{"Deployment", "web"}, {"Service", "web"}} {
+		obj := findObject(t, result, key[0], key[1])
+		if got := annotations(t, obj)[dependsOnAnnotation]; got != "database,cache" {
+			t.Errorf("%s %s: got depends-on annotation %q, want %q", key[0], key[1], got, "database,cache")
+		}
+	}
+	for _, name := range []string{"database", "cache"} {
+		obj := findObject(t, result, "ReplicationController", name)
+		if got, ok := annotations(t, obj)[dependsOnAnnotation]; ok {
+			t.Errorf("%s: got depends-on annotation %q, want none", name, got)
+		}
+	}
+}
+
+func TestDependsOnAnnotationLongForm(t *testing.T) {
+	result := convertProject(t, `version: "2.1"
+services:
+  web:
+    image: nginx
+    depends_on:
+      db:
+        condition: service_started
+      cache:
+        condition: service_started
+      api:
+        condition: service_started
+  db:
+    image: postgres
+  cache:
+    image: redis
+  api:
+    image: api
+`, nil, Options{})
+
+	obj := findObject(t, result, "ReplicationController", "web")
+	if got := annotations(t, obj)[dependsOnAnnotation]; got != "db,cache,api" {
+		t.Errorf("got depends-on annotation %q, want %q", got, "db,cache,api")
+	}
+}
+
+func TestDependsOnOrderOverride(t *testing.T) {
+	// The dependencies an override file adds come after the ones of the
+	// file it overrides.
+	compose := writeProject(t, `version: "2.1"
+services:
+  web:
+    image: nginx
+    depends_on:
+      db:
+        condition: service_started
+  db:
+    image: postgres
+  cache:
+    image: redis
+`, map[string]string{"docker-compose.override.yml": `version: "2.1"
+services:
+  web:
+    depends_on:
+      cache:
+        condition: service_started
+      db:
+        condition: service_healthy
+`})
+	result, err := Convert(filepath.Dir(compose), Options{})
+	if err != nil {
+		t.Fatalf("Convert: %v", err)
+	}
+	obj := findObject(t, result, "ReplicationController", "web")
+	if got := annotations(t, obj)[dependsOnAnnotation]; got != "db,cache" {
+		t.Errorf("got depends-on annotation %q, want %q", got, "db,cache")
+	}
+}
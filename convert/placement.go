@@ -0,0 +1,98 @@
+/*
+Copyright 2015 Kelsey Hightower All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package convert
+
+import (
+	"strings"
+
+	"k8s.io/kubernetes/pkg/api"
+)
+
+// constraintNodeLabels maps the swarm node attributes that have a well-known
+// Kubernetes node label counterpart.
+var constraintNodeLabels = map[string]string{
+	"node.hostname":      "kubernetes.io/hostname",
+	"node.platform.os":   "beta.kubernetes.io/os",
+	"node.platform.arch": "beta.kubernetes.io/arch",
+}
+
+// placementConstraints translates swarm placement constraints of service.
+// Equality constraints become node selector entries and inequality
+// constraints node affinity requirements. Constraints on attributes without
+// a Kubernetes node label counterpart are skipped with a warning.
+func (c *converter) placementConstraints(service string, constraints []string) (map[string]string, *api.NodeAffinity) {
+	var nodeSelector map[string]string
+	var requirements []api.NodeSelectorRequirement
+
+	for _, constraint := range constraints {
+		op, operator := "==", api.NodeSelectorOpIn
+		if strings.Contains(constraint, "!=") {
+			op, operator = "!=", api.NodeSelectorOpNotIn
+		}
+		parts := strings.SplitN(constraint, op, 2)
+		if len(parts) != 2 {
+			c.report.skipf(service, "deploy.placement.constraints", "Ignoring unsupported placement constraint %q for service %s", constraint, service)
+			continue
+		}
+		attribute, value := strings.TrimSpace(parts[0]), strings.TrimSpace(parts[1])
+
+		label, ok := constraintNodeLabels[attribute]
+		if strings.HasPrefix(attribute, "node.labels.") {
+			label, ok = strings.TrimPrefix(attribute, "node.labels."), true
+		}
+		if !ok || label == "" || value == "" {
+			c.report.skipf(service, "deploy.placement.constraints", "Ignoring unsupported placement constraint %q for service %s", constraint, service)
+			continue
+		}
+
+		if operator == api.NodeSelectorOpIn {
+			if nodeSelector == nil {
+				nodeSelector = make(map[string]string)
+			}
+			nodeSelector[label] = value
+			continue
+		}
+		requirements = append(requirements, api.NodeSelectorRequirement{
+			Key:      label,
+			Operator: operator,
+			Values:   []string{value},
+		})
+	}
+
+	if len(requirements) == 0 {
+		return nodeSelector, nil
+	}
+	return nodeSelector, &api.NodeAffinity{
+		RequiredDuringSchedulingIgnoredDuringExecution: &api.NodeSelector{
+			NodeSelectorTerms: []api.NodeSelectorTerm{{MatchExpressions: requirements}},
+		},
+	}
+}
+
+// platformNodeSelector translates the platform of service, given as
+// os[/arch[/variant]], to node selector entries for the OS and architecture.
+// The variant has no node label counterpart. A platform that cannot be
+// parsed is skipped with a warning.
+func (c *converter) platformNodeSelector(service, platform string) map[string]string {
+	parts := strings.Split(strings.ToLower(platform), "/")
+	if len(parts) > 3 || parts[0] == "" || len(parts) > 1 && parts[1] == "" {
+		c.report.skipf(service, "platform", "Ignoring unrecognized platform %s for service %s", platform, service)
+		return nil
+	}
+	nodeSelector := map[string]string{constraintNodeLabels["node.platform.os"]: parts[0]}
+	if len(parts) > 1 {
+		nodeSelector[constraintNodeLabels["node.platform.arch"]] = parts[1]
+	}
+	return nodeSelector
+}
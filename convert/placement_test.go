@@ -0,0 +1,104 @@
+/*
+Copyright 2015 Kelsey Hightower All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package convert
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"k8s.io/kubernetes/pkg/api"
+)
+
+// podAffinity decodes the affinity annotation of the pods of the named
+// replication controller. It returns nil when there is none.
+func podAffinity(t *testing.T, result *Result, rcName string) *api.Affinity {
+	t.Helper()
+	rc := findObject(t, result, "ReplicationController", rcName).Object.(*api.ReplicationController)
+	data, ok := rc.Spec.Template.Annotations[api.AffinityAnnotationKey]
+	if !ok {
+		return nil
+	}
+	var affinity api.Affinity
+	if err := json.Unmarshal([]byte(data), &affinity); err != nil {
+		t.Fatalf("invalid affinity annotation %s: %v", data, err)
+	}
+	return &affinity
+}
+
+func TestPlatformNodeSelector(t *testing.T) {
+	result := convertProject(t, `version: "2.4"
+services:
+  web:
+    image: nginx
+    platform: linux/arm64
+    isolation: hyperv
+`, nil, Options{})
+
+	want := map[string]string{
+		"beta.kubernetes.io/os":   "linux",
+		"beta.kubernetes.io/arch": "arm64",
+	}
+	if got := podSpec(t, result, "web").NodeSelector; !reflect.DeepEqual(got, want) {
+		t.Errorf("got node selector %v, want %v", got, want)
+	}
+	if got := result.Report.Services["web"].Skipped; !reflect.DeepEqual(got, []string{"isolation"}) {
+		t.Errorf("got skipped options %q, want [isolation]", got)
+	}
+}
+
+func TestPlacementConstraints(t *testing.T) {
+	const compose = `version: "3"
+services:
+  web:
+    image: nginx
+    deploy:
+      placement:
+        constraints:
+          - node.labels.zone == us-east
+          - node.hostname != worker-1
+          - node.labels.disk!=hdd
+          - node.role == manager
+          - engine.labels.gpu == true
+`
+	result := convertProject(t, compose, nil, Options{})
+
+	want := map[string]string{"zone": "us-east"}
+	if got := podSpec(t, result, "web").NodeSelector; !reflect.DeepEqual(got, want) {
+		t.Errorf("got node selector %v, want %v", got, want)
+	}
+	affinity := podAffinity(t, result, "web")
+	if affinity == nil || affinity.NodeAffinity == nil || affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution == nil {
+		t.Fatalf("got affinity %s, want a required node affinity", toJSON(affinity))
+	}
+	wantTerms := []api.NodeSelectorTerm{{MatchExpressions: []api.NodeSelectorRequirement{
+		{Key: "kubernetes.io/hostname", Operator: api.NodeSelectorOpNotIn, Values: []string{"worker-1"}},
+		{Key: "disk", Operator: api.NodeSelectorOpNotIn, Values: []string{"hdd"}},
+	}}}
+	if got := affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms; !reflect.DeepEqual(got, wantTerms) {
+		t.Errorf("got node selector terms %s, want %s", toJSON(got), toJSON(wantTerms))
+	}
+
+	// The constraints without a node label counterpart are left out with a
+	// warning, and fail the conversion in strict mode.
+	report := result.Report.Services["web"]
+	if len(report.Skipped) != 2 || report.Skipped[0] != "deploy.placement.constraints" || len(report.Warnings) != 2 {
+		t.Errorf("got skipped options %q and warnings %q, want two unsupported constraints", report.Skipped, report.Warnings)
+	}
+	_, err := Convert(writeProject(t, compose, nil), Options{Strict: true})
+	if err == nil || !strings.Contains(err.Error(), "node.role == manager") {
+		t.Errorf("got error %v, want one about node.role == manager", err)
+	}
+}
@@ -0,0 +1,137 @@
+/*
+Copyright 2015 Kelsey Hightower All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package convert
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+)
+
+// Report is a machine-readable summary of a conversion.
+type Report struct {
+	// Services holds the summary of each converted service.
+	Services map[string]*ServiceReport `json:"services"`
+	// Objects lists the objects that do not belong to a single service,
+	// such as secrets and config maps, as Kind/name.
+	Objects []string `json:"objects,omitempty"`
+	// Warnings lists the problems that do not belong to a single service.
+	Warnings []string `json:"warnings,omitempty"`
+}
+
+// ServiceReport summarises the conversion of a single service.
+type ServiceReport struct {
+	// Objects lists the kinds of the objects created for the service.
+	Objects []string `json:"objects"`
+	// Warnings lists the problems found while converting the service.
+	Warnings []string `json:"warnings,omitempty"`
+	// Skipped lists the compose options that were not translated.
+	Skipped []string `json:"skipped,omitempty"`
+
+	// unlogged holds the options skipped since the last flushSkipped, and
+	// their warnings.
+	unlogged         []string
+	unloggedWarnings []string
+}
+
+func newReport() *Report {
+	return &Report{Services: make(map[string]*ServiceReport)}
+}
+
+func (r *Report) service(name string) *ServiceReport {
+	s, ok := r.Services[name]
+	if !ok {
+		s = &ServiceReport{Objects: []string{}}
+		r.Services[name] = s
+	}
+	return s
+}
+
+// addObject records an object created for service. Objects that do not
+// belong to a service are recorded when service is empty.
+func (r *Report) addObject(service, kind, name string) {
+	if service == "" {
+		r.Objects = append(r.Objects, kind+"/"+name)
+		return
+	}
+	s := r.service(service)
+	s.Objects = append(s.Objects, kind)
+}
+
+// warnf logs a problem that does not stop the conversion.
+func (r *Report) warnf(format string, v ...interface{}) {
+	msg := fmt.Sprintf(format, v...)
+	log.Printf("Warning: %s", msg)
+	r.Warnings = append(r.Warnings, msg)
+}
+
+// serviceWarnf logs a problem with service that does not stop the conversion.
+func (r *Report) serviceWarnf(service, format string, v ...interface{}) {
+	msg := fmt.Sprintf(format, v...)
+	log.Printf("Warning: %s", msg)
+	s := r.service(service)
+	s.Warnings = append(s.Warnings, msg)
+}
+
+// skipf records that the compose option field of service was not
+// translated. The warning is logged by flushSkipped, together with the other
+// options skipped for the service.
+func (r *Report) skipf(service, field, format string, v ...interface{}) {
+	msg := fmt.Sprintf(format, v...)
+	s := r.service(service)
+	s.Warnings = append(s.Warnings, msg)
+	s.Skipped = append(s.Skipped, field)
+	s.unlogged = append(s.unlogged, field)
+	s.unloggedWarnings = append(s.unloggedWarnings, msg)
+}
+
+// flushSkipped logs the options skipped for service since the last call. A
+// single option is logged with its own warning, and several are listed in a
+// single line to keep the noise down. The report keeps every warning.
+func (r *Report) flushSkipped(service string) {
+	s := r.service(service)
+	switch len(s.unlogged) {
+	case 0:
+		return
+	case 1:
+		log.Printf("Warning: %s", s.unloggedWarnings[0])
+	default:
+		var fields []string
+		seen := make(map[string]bool)
+		for _, field := range s.unlogged {
+			if !seen[field] {
+				fields = append(fields, field)
+				seen[field] = true
+			}
+		}
+		log.Printf("Warning: Ignoring unsupported options of service %s: %s", service, strings.Join(fields, ", "))
+	}
+	s.unlogged, s.unloggedWarnings = nil, nil
+}
+
+// allWarnings returns the warnings of the conversion, including those of the
+// services in name order.
+func (r *Report) allWarnings() []string {
+	warnings := append([]string(nil), r.Warnings...)
+	names := make([]string, 0, len(r.Services))
+	for name := range r.Services {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		warnings = append(warnings, r.Services[name].Warnings...)
+	}
+	return warnings
+}
@@ -0,0 +1,85 @@
+/*
+Copyright 2015 Kelsey Hightower All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package convert
+
+import (
+	"bytes"
+	"log"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// captureLog returns what f logs.
+func captureLog(t *testing.T, f func()) string {
+	t.Helper()
+	var buf bytes.Buffer
+	flags := log.Flags()
+	log.SetOutput(&buf)
+	log.SetFlags(0)
+	defer func() {
+		log.SetOutput(os.Stderr)
+		log.SetFlags(flags)
+	}()
+	f()
+	return buf.String()
+}
+
+func TestSkippedOptionsWarning(t *testing.T) {
+	var result *Result
+	logged := captureLog(t, func() {
+		result = convertProject(t, `version: "2.4"
+services:
+  web:
+    image: nginx
+    isolation: hyperv
+    mem_swappiness: 10
+    cpu_percent: 50
+  database:
+    image: postgres
+    isolation: hyperv
+`, nil, Options{})
+	})
+
+	// The options skipped for web are logged together, the single one
+	// skipped for database with its own warning.
+	want := []string{
+		"Warning: Ignoring isolation for service database, it has no Kubernetes equivalent",
+		"Warning: Ignoring unsupported options of service web: cpu_percent, isolation, mem_swappiness",
+	}
+	if got := strings.Split(strings.TrimSpace(logged), "\n"); !reflect.DeepEqual(got, want) {
+		t.Errorf("got log lines %q, want %q", got, want)
+	}
+
+	// The report keeps a warning for each of them.
+	web := result.Report.Services["web"]
+	if len(web.Skipped) != 3 || len(web.Warnings) != 3 {
+		t.Errorf("got skipped options %q with warnings %q, want three of each", web.Skipped, web.Warnings)
+	}
+}
+
+func TestFlushSkipped(t *testing.T) {
+	r := newReport()
+	r.skipf("web", "tmpfs", "first tmpfs")
+	r.skipf("web", "tmpfs", "second tmpfs")
+	r.skipf("web", "sysctls", "sysctl")
+	logged := captureLog(t, func() {
+		r.flushSkipped("web")
+		r.flushSkipped("web")
+	})
+	if want := "Warning: Ignoring unsupported options of service web: tmpfs, sysctls\n"; logged != want {
+		t.Errorf("got log %q, want %q", logged, want)
+	}
+}
@@ -0,0 +1,217 @@
+/*
+Copyright 2015 Kelsey Hightower All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package convert
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/resource"
+)
+
+// containerResources translates the resource settings of a service. Resources
+// the service sets neither a request nor a limit for get the default
+// resources of the conversion.
+func (c *converter) containerResources(name string, extras serviceExtras) (api.ResourceRequirements, error) {
+	resources, err := c.serviceResources(name, extras)
+	if err != nil {
+		return resources, err
+	}
+	defaults := c.opts.DefaultResources
+	for _, r := range []api.ResourceName{api.ResourceCPU, api.ResourceMemory} {
+		if _, ok := resources.Requests[r]; ok {
+			continue
+		}
+		if _, ok := resources.Limits[r]; ok {
+			continue
+		}
+		if q, ok := defaults.Requests[r]; ok {
+			if resources.Requests == nil {
+				resources.Requests = api.ResourceList{}
+			}
+			resources.Requests[r] = q
+		}
+		if q, ok := defaults.Limits[r]; ok {
+			if resources.Limits == nil {
+				resources.Limits = api.ResourceList{}
+			}
+			resources.Limits[r] = q
+		}
+	}
+	return resources, nil
+}
+
+// serviceResources translates the resource settings of a service. The
+// deploy.resources section takes precedence over the legacy cpu_count,
+// cpu_percent, mem_limit and mem_reservation options.
+func (c *converter) serviceResources(name string, extras serviceExtras) (api.ResourceRequirements, error) {
+	var resources api.ResourceRequirements
+
+	if extras.Deploy != nil && (extras.Deploy.Resources.Limits != nil || extras.Deploy.Resources.Reservations != nil) {
+		limits, err := resourceList(extras.Deploy.Resources.Limits)
+		if err != nil {
+			return resources, fmt.Errorf("invalid deploy.resources.limits: %v", err)
+		}
+		requests, err := resourceList(extras.Deploy.Resources.Reservations)
+		if err != nil {
+			return resources, fmt.Errorf("invalid deploy.resources.reservations: %v", err)
+		}
+		resources.Limits = limits
+		resources.Requests = requests
+
+		if extras.CPUCount != 0 {
+			c.report.skipf(name, "cpu_count", "Ignoring cpu_count for service %s in favour of deploy.resources", name)
+		}
+		if extras.CPUPercent != 0 {
+			c.report.skipf(name, "cpu_percent", "Ignoring cpu_percent for service %s in favour of deploy.resources", name)
+		}
+		if extras.MemLimit != "" {
+			c.report.skipf(name, "mem_limit", "Ignoring mem_limit for service %s in favour of deploy.resources", name)
+		}
+		if extras.MemReservation != "" {
+			c.report.skipf(name, "mem_reservation", "Ignoring mem_reservation for service %s in favour of deploy.resources", name)
+		}
+		return resources, nil
+	}
+
+	if extras.CPUCount < 0 {
+		return resources, fmt.Errorf("invalid cpu_count %d", extras.CPUCount)
+	}
+	if extras.CPUCount > 0 {
+		resources.Limits = api.ResourceList{
+			api.ResourceCPU: *resource.NewMilliQuantity(extras.CPUCount*1000, resource.DecimalSI),
+		}
+	}
+	if extras.CPUPercent != 0 {
+		c.report.skipf(name, "cpu_percent", "Ignoring cpu_percent for service %s, it has no Kubernetes equivalent", name)
+	}
+
+	// mem_limit and mem_reservation map to the memory limit and request.
+	limits, err := resourceList(&deployResourceSpec{Memory: extras.MemLimit})
+	if err != nil {
+		return resources, fmt.Errorf("invalid mem_limit: %v", err)
+	}
+	requests, err := resourceList(&deployResourceSpec{Memory: extras.MemReservation})
+	if err != nil {
+		return resources, fmt.Errorf("invalid mem_reservation: %v", err)
+	}
+	for r, q := range limits {
+		if resources.Limits == nil {
+			resources.Limits = api.ResourceList{}
+		}
+		resources.Limits[r] = q
+	}
+	resources.Requests = requests
+	return resources, nil
+}
+
+// resourceList converts a deploy resource spec into a Kubernetes resource
+// list. Memory sizes are parsed with parseQuantity.
+func resourceList(spec *deployResourceSpec) (api.ResourceList, error) {
+	if spec == nil {
+		return nil, nil
+	}
+
+	list := api.ResourceList{}
+	if spec.CPUs != "" {
+		cpus, err := resource.ParseQuantity(spec.CPUs)
+		if err != nil {
+			return nil, fmt.Errorf("cpus %s: %v", spec.CPUs, err)
+		}
+		list[api.ResourceCPU] = cpus
+	}
+	if spec.Memory != "" {
+		memory, err := parseQuantity(spec.Memory)
+		if err != nil {
+			return nil, fmt.Errorf("memory %s: %v", spec.Memory, err)
+		}
+		list[api.ResourceMemory] = memory
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return list, nil
+}
+
+// Labels requesting GPUs for a service.
+const (
+	gpuLabel       = "kompose.gpu"
+	gpuVendorLabel = "kompose.gpu.vendor"
+)
+
+// defaultGPUVendor is the vendor of the GPUs requested without the
+// kompose.gpu.vendor label.
+const defaultGPUVendor = "nvidia.com"
+
+// gpuLimit returns the resource name and count of the GPUs requested with
+// the kompose.gpu label. The kompose.gpu.vendor label takes either a vendor
+// domain such as amd.com, giving amd.com/gpu, or a full resource name. The
+// resource name is empty when no GPU is requested.
+func gpuLimit(labels map[string]string) (api.ResourceName, resource.Quantity, error) {
+	value, ok := labels[gpuLabel]
+	if !ok {
+		if _, ok := labels[gpuVendorLabel]; ok {
+			return "", resource.Quantity{}, fmt.Errorf("%s requires the %s label", gpuVendorLabel, gpuLabel)
+		}
+		return "", resource.Quantity{}, nil
+	}
+	count, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || count <= 0 {
+		return "", resource.Quantity{}, fmt.Errorf("%s %q is not a positive integer", gpuLabel, value)
+	}
+	name := labels[gpuVendorLabel]
+	if name == "" {
+		name = defaultGPUVendor
+	}
+	if !strings.Contains(name, "/") {
+		name += "/gpu"
+	}
+	return api.ResourceName(name), *resource.NewQuantity(count, resource.DecimalSI), nil
+}
+
+// byteSize matches a compose byte value, a number with an optional unit.
+var byteSize = regexp.MustCompile(`^(?i)(\d+(?:\.\d+)?)\s*(b|k|kb|m|mb|g|gb)?$`)
+
+// byteUnits are the multipliers of the compose byte units, which are binary
+// like in Docker: 50m and 50mb are both 50MiB.
+var byteUnits = map[string]int64{
+	"":   1,
+	"b":  1,
+	"k":  1 << 10,
+	"kb": 1 << 10,
+	"m":  1 << 20,
+	"mb": 1 << 20,
+	"g":  1 << 30,
+	"gb": 1 << 30,
+}
+
+// parseQuantity parses a compose byte value such as mem_limit into a
+// quantity. It takes a bare number of bytes or a number followed by one of
+// the units b, k, kb, m, mb, g or gb, in any case. Fractions are rounded down
+// to whole bytes.
+func parseQuantity(value string) (resource.Quantity, error) {
+	match := byteSize.FindStringSubmatch(strings.TrimSpace(value))
+	if match == nil {
+		return resource.Quantity{}, fmt.Errorf("invalid size %q, must be a number with an optional unit b, k, m or g", value)
+	}
+	amount, err := strconv.ParseFloat(match[1], 64)
+	if err != nil {
+		return resource.Quantity{}, fmt.Errorf("invalid size %q: %v", value, err)
+	}
+	bytes := int64(amount * float64(byteUnits[strings.ToLower(match[2])]))
+	return *resource.NewQuantity(bytes, resource.BinarySI), nil
+}
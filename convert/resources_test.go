@@ -0,0 +1,452 @@
+/*
+Copyright 2015 Kelsey Hightower All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package convert
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/resource"
+)
+
+// quantities returns the resource list given as resource names and
+// quantities.
+func quantities(pairs ...string) api.ResourceList {
+	list := api.ResourceList{}
+	for i := 0; i < len(pairs); i += 2 {
+		list[api.ResourceName(pairs[i])] = resource.MustParse(pairs[i+1])
+	}
+	return list
+}
+
+// sameResources reports whether the two resource lists hold equal
+// quantities, regardless of how they are written.
+func sameResources(a, b api.ResourceList) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for name, q := range a {
+		other, ok := b[name]
+		if !ok || q.Cmp(other) != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func TestDefaultResources(t *testing.T) {
+	opts := Options{
+		DefaultResources: api.ResourceRequirements{
+			Requests: quantities("cpu", "100m", "memory", "128Mi"),
+			Limits:   quantities("cpu", "500m", "memory", "256Mi"),
+		},
+	}
+	result := convertProject(t, `version: "2"
+services:
+  plain:
+    image: nginx
+  memory:
+    image: nginx
+    mem_limit: 1g
+  deployed:
+    image: nginx
+    deploy:
+      resources:
+        limits:
+          cpus: "2"
+          memory: 512m
+        reservations:
+          memory: 64m
+`, nil, opts)
+
+	tests := []struct {
+		service  string
+		requests api.ResourceList
+		limits   api.ResourceList
+	}{
+		// The defaults apply to a service without resources.
+		{"plain", quantities("cpu", "100m", "memory", "128Mi"), quantities("cpu", "500m", "memory", "256Mi")},
+		// The memory of the service wins, the CPU defaults still apply.
+		{"memory", quantities("cpu", "100m"), quantities("cpu", "500m", "memory", "1Gi")},
+		// Resources set by the service win over the defaults.
+		{"deployed", quantities("memory", "64Mi"), quantities("cpu", "2", "memory", "512Mi")},
+	}
+	for _, test := range tests {
+		resources := podSpec(t, result, test.service).Containers[0].Resources
+		if !sameResources(resources.Requests, test.requests) {
+			t.Errorf("%s: got requests %s, want %s", test.service, toJSON(resources.Requests), toJSON(test.requests))
+		}
+		if !sameResources(resources.Limits, test.limits) {
+			t.Errorf("%s: got limits %s, want %s", test.service, toJSON(resources.Limits), toJSON(test.limits))
+		}
+	}
+}
+
+func TestParseQuantity(t *testing.T) {
+	tests := []struct {
+		value string
+		bytes int64
+	}{
+		{"0", 0},
+		{"1024", 1024},
+		{"100b", 100},
+		{"100B", 100},
+		{"2k", 2 << 10},
+		{"2kb", 2 << 10},
+		{"2KB", 2 << 10},
+		{"64m", 64 << 20},
+		{"64mb", 64 << 20},
+		{"64M", 64 << 20},
+		{"1g", 1 << 30},
+		{"1gb", 1 << 30},
+		{"1G", 1 << 30},
+		{"1.5g", 3 << 29},
+		{"0.5k", 512},
+		{"1.0001k", 1024},
+		{"512 m", 512 << 20},
+		{" 64m ", 64 << 20},
+	}
+	for _, test := range tests {
+		q, err := parseQuantity(test.value)
+		if err != nil {
+			t.Errorf("parseQuantity(%q): %v", test.value, err)
+			continue
+		}
+		if q.Value() != test.bytes {
+			t.Errorf("parseQuantity(%q) = %d bytes, want %d", test.value, q.Value(), test.bytes)
+		}
+	}
+
+	for _, value := range []string{
+		"",
+		"m",
+		"-1m",
+		"1.m",
+		".5m",
+		"1e3",
+		"1t",
+		"1kib",
+		"1Mi",
+		"64 mm",
+		"one",
+		"1,5g",
+	} {
+		if q, err := parseQuantity(value); err == nil {
+			t.Errorf("parseQuantity(%q) = %s, want an error", value, q.String())
+		}
+	}
+}
+
+func TestShmSize(t *testing.T) {
+	result := convertProject(t, `version: "2"
+services:
+  browser:
+    image: chrome
+    shm_size: 2gb
+`, nil, Options{})
+
+	spec := podSpec(t, result, "browser")
+	if len(spec.Volumes) != 1 || spec.Volumes[0].EmptyDir == nil || spec.Volumes[0].EmptyDir.Medium != api.StorageMediumMemory {
+		t.Fatalf("got volumes %s, want a memory-backed empty dir", toJSON(spec.Volumes))
+	}
+	want := []api.VolumeMount{{Name: spec.Volumes[0].Name, MountPath: "/dev/shm"}}
+	if got := spec.Containers[0].VolumeMounts; !reflect.DeepEqual(got, want) {
+		t.Errorf("got mounts %s, want %s", toJSON(got), toJSON(want))
+	}
+	if got := result.Report.Services["browser"].Skipped; !reflect.DeepEqual(got, []string{"shm_size"}) {
+		t.Errorf("got skipped options %q, want [shm_size]", got)
+	}
+
+	_, err := Convert(writeProject(t, `version: "2"
+services:
+  browser:
+    image: chrome
+    shm_size: 2tb
+`, nil), Options{})
+	if err == nil {
+		t.Error("converting shm_size 2tb succeeded, want an error")
+	}
+}
+
+func TestServiceResources(t *testing.T) {
+	tests := []struct {
+		version  string
+		service  string
+		requests api.ResourceList
+		limits   api.ResourceList
+		skipped  []string
+	}{
+		{"2.2", "cpu_count: 2", nil, quantities("cpu", "2"), nil},
+		{"2.2", "cpu_count: 1\n    cpu_percent: 50", nil, quantities("cpu", "1"), []string{"cpu_percent"}},
+		{"3", "deploy:\n      resources:\n        limits:\n          cpus: \"0.5\"\n          memory: 50m",
+			nil, quantities("cpu", "500m", "memory", "50Mi"), nil},
+		{"3", "deploy:\n      resources:\n        reservations:\n          cpus: \"0.25\"\n          memory: 20m",
+			quantities("cpu", "250m", "memory", "20Mi"), nil, nil},
+		{"3", "deploy:\n      resources:\n        limits:\n          cpus: \"2\"\n        reservations:\n          cpus: \"1\"",
+			quantities("cpu", "1"), quantities("cpu", "2"), nil},
+		// deploy.resources wins over cpu_count.
+		{"2.4", "cpu_count: 4\n    deploy:\n      resources:\n        limits:\n          cpus: \"1\"",
+			nil, quantities("cpu", "1"), []string{"cpu_count"}},
+	}
+	for _, test := range tests {
+		result := convertProject(t, `version: "`+test.version+`"
+services:
+  app:
+    image: app
+    `+test.service+`
+`, nil, Options{})
+		resources := podSpec(t, result, "app").Containers[0].Resources
+		if !sameResources(resources.Requests, test.requests) {
+			t.Errorf("%q: got requests %s, want %s", test.service, toJSON(resources.Requests), toJSON(test.requests))
+		}
+		if !sameResources(resources.Limits, test.limits) {
+			t.Errorf("%q: got limits %s, want %s", test.service, toJSON(resources.Limits), toJSON(test.limits))
+		}
+		if got := result.Report.Services["app"].Skipped; !reflect.DeepEqual(got, test.skipped) {
+			t.Errorf("%q: got skipped options %q, want %q", test.service, got, test.skipped)
+		}
+	}
+
+	for _, service := range []string{
+		"cpu_count: -1",
+		"deploy:\n      resources:\n        limits:\n          cpus: half",
+		"deploy:\n      resources:\n        reservations:\n          memory: 1tb",
+	} {
+		_, err := Convert(writeProject(t, `version: "2.4"
+services:
+  app:
+    image: app
+    `+service+`
+`, nil), Options{})
+		if err == nil {
+			t.Errorf("converting %q succeeded, want an error", service)
+		}
+	}
+}
+
+func TestMemoryResources(t *testing.T) {
+	tests := []struct {
+		service  string
+		requests api.ResourceList
+		limits   api.ResourceList
+		skipped  []string
+	}{
+		{"mem_limit: 512m", nil, quantities("memory", "512Mi"), nil},
+		{"mem_reservation: 128m", quantities("memory", "128Mi"), nil, nil},
+		{"mem_limit: 1g\n    mem_reservation: 256mb", quantities("memory", "256Mi"), quantities("memory", "1Gi"), nil},
+		{"mem_limit: 1073741824", nil, quantities("memory", "1Gi"), nil},
+		{"mem_limit: 1g\n    cpu_count: 2", nil, quantities("cpu", "2", "memory", "1Gi"), nil},
+		// deploy.resources wins over mem_limit and mem_reservation.
+		{"mem_limit: 1g\n    mem_reservation: 256m\n    deploy:\n      resources:\n        limits:\n          memory: 2g",
+			nil, quantities("memory", "2Gi"), []string{"mem_limit", "mem_reservation"}},
+	}
+	for _, test := range tests {
+		result := convertProject(t, `version: "2.4"
+services:
+  app:
+    image: app
+    `+test.service+`
+`, nil, Options{})
+		resources := podSpec(t, result, "app").Containers[0].Resources
+		if !sameResources(resources.Requests, test.requests) {
+			t.Errorf("%q: got requests %s, want %s", test.service, toJSON(resources.Requests), toJSON(test.requests))
+		}
+		if !sameResources(resources.Limits, test.limits) {
+			t.Errorf("%q: got limits %s, want %s", test.service, toJSON(resources.Limits), toJSON(test.limits))
+		}
+		if got := result.Report.Services["app"].Skipped; !reflect.DeepEqual(got, test.skipped) {
+			t.Errorf("%q: got skipped options %q, want %q", test.service, got, test.skipped)
+		}
+	}
+
+	for _, service := range []string{"mem_limit: lots", "mem_reservation: 1tb"} {
+		_, err := Convert(writeProject(t, `version: "2.4"
+services:
+  app:
+    image: app
+    `+service+`
+`, nil), Options{})
+		if err == nil {
+			t.Errorf("converting %q succeeded, want an error", service)
+		}
+	}
+}
+
+func TestRuntime(t *testing.T) {
+	result := convertProject(t, `version: "2.3"
+services:
+  train:
+    image: tensorflow
+    runtime: nvidia
+    labels:
+      kompose.gpu: "1"
+  other:
+    image: app
+    runtime: My_Runtime
+`, nil, Options{})
+
+	// The runtime is kept as an annotation, and the GPUs are a limit of the
+	// container.
+	train := findObject(t, result, "ReplicationController", "train").Object.(*api.ReplicationController)
+	if got := train.Spec.Template.Annotations[runtimeAnnotation]; got != "nvidia" {
+		t.Errorf("got runtime annotation %q, want nvidia", got)
+	}
+	if got, want := train.Spec.Template.Spec.Containers[0].Resources.Limits, quantities("nvidia.com/gpu", "1"); !sameResources(got, want) {
+		t.Errorf("got limits %s, want %s", toJSON(got), toJSON(want))
+	}
+
+	other := findObject(t, result, "ReplicationController", "other").Object.(*api.ReplicationController)
+	if got, ok := other.Spec.Template.Annotations[runtimeAnnotation]; ok {
+		t.Errorf("got runtime annotation %q for an invalid runtime, want none", got)
+	}
+	if got := result.Report.Services["other"].Skipped; !reflect.DeepEqual(got, []string{"runtime"}) {
+		t.Errorf("got skipped options %q, want [runtime]", got)
+	}
+}
+
+func TestGPULimit(t *testing.T) {
+	tests := []struct {
+		labels map[string]string
+		name   api.ResourceName
+		count  int64
+	}{
+		{map[string]string{}, "", 0},
+		{map[string]string{gpuLabel: "2"}, "nvidia.com/gpu", 2},
+		{map[string]string{gpuLabel: "1", gpuVendorLabel: "amd.com"}, "amd.com/gpu", 1},
+		// A full resource name selects the alpha GPU resource of older
+		// clusters.
+		{map[string]string{gpuLabel: "1", gpuVendorLabel: string(api.ResourceNvidiaGPU)}, api.ResourceNvidiaGPU, 1},
+	}
+	for _, test := range tests {
+		name, count, err := gpuLimit(test.labels)
+		if err != nil {
+			t.Errorf("gpuLimit(%v): %v", test.labels, err)
+			continue
+		}
+		if name != test.name || name != "" && count.Value() != test.count {
+			t.Errorf("gpuLimit(%v) = %s %s, want %s %d", test.labels, name, count.String(), test.name, test.count)
+		}
+	}
+
+	for _, labels := range []map[string]string{
+		{gpuLabel: "0"},
+		{gpuLabel: "-1"},
+		{gpuLabel: "one"},
+		{gpuVendorLabel: "amd.com"},
+	} {
+		if _, _, err := gpuLimit(labels); err == nil {
+			t.Errorf("gpuLimit(%v) succeeded, want an error", labels)
+		}
+	}
+
+	// The GPUs are added to the other limits of the container.
+	result := convertProject(t, `version: "2.4"
+services:
+  train:
+    image: tensorflow
+    mem_limit: 1g
+    labels:
+      kompose.gpu: "2"
+`, nil, Options{})
+	want := quantities("memory", "1Gi", "nvidia.com/gpu", "2")
+	if got := podSpec(t, result, "train").Containers[0].Resources.Limits; !sameResources(got, want) {
+		t.Errorf("got limits %s, want %s", toJSON(got), toJSON(want))
+	}
+}
+
+func TestOOMAnnotations(t *testing.T) {
+	tests := []struct {
+		service     string
+		annotations map[string]string
+		requests    api.ResourceList
+	}{
+		{"oom_score_adj: 500", map[string]string{oomScoreAdjAnnotation: "500"}, nil},
+		{"oom_kill_disable: true", map[string]string{oomKillDisableAnnotation: "true"}, nil},
+		{"oom_kill_disable: false", map[string]string{}, nil},
+		{"oom_score_adj: -500\n    oom_kill_disable: true", map[string]string{oomScoreAdjAnnotation: "-500", oomKillDisableAnnotation: "true"}, nil},
+		// A negative adjustment raises the requests to the limits, for the
+		// Guaranteed QoS class.
+		{"oom_score_adj: -1000\n    mem_limit: 1g", map[string]string{oomScoreAdjAnnotation: "-1000"}, quantities("memory", "1Gi")},
+		{"oom_score_adj: 1000\n    mem_limit: 1g", map[string]string{oomScoreAdjAnnotation: "1000"}, nil},
+		{"oom_score_adj: -10\n    mem_limit: 1g\n    mem_reservation: 512m", map[string]string{oomScoreAdjAnnotation: "-10"}, quantities("memory", "512Mi")},
+	}
+	for _, test := range tests {
+		result := convertProject(t, `version: "2.4"
+services:
+  app:
+    image: app
+    `+test.service+`
+`, nil, Options{})
+		rc := findObject(t, result, "ReplicationController", "app").Object.(*api.ReplicationController)
+		got := map[string]string{}
+		for _, key := range []string{oomScoreAdjAnnotation, oomKillDisableAnnotation} {
+			if value, ok := rc.Spec.Template.Annotations[key]; ok {
+				got[key] = value
+			}
+		}
+		if !reflect.DeepEqual(got, test.annotations) {
+			t.Errorf("%q: got annotations %v, want %v", test.service, got, test.annotations)
+		}
+		if requests := rc.Spec.Template.Spec.Containers[0].Resources.Requests; !sameResources(requests, test.requests) {
+			t.Errorf("%q: got requests %s, want %s", test.service, toJSON(requests), toJSON(test.requests))
+		}
+	}
+
+	for _, score := range []string{"-1001", "1001"} {
+		_, err := Convert(writeProject(t, `version: "2.4"
+services:
+  app:
+    image: app
+    oom_score_adj: `+score+`
+`, nil), Options{})
+		if err == nil {
+			t.Errorf("converting oom_score_adj %s succeeded, want an error", score)
+		}
+	}
+}
+
+func TestUlimits(t *testing.T) {
+	tests := []struct {
+		ulimits string
+		want    map[string]string
+	}{
+		{"nproc: 65535", map[string]string{ulimitAnnotationPrefix + "nproc": "65535:65535"}},
+		{"nofile:\n        soft: 20000\n        hard: 40000", map[string]string{ulimitAnnotationPrefix + "nofile": "20000:40000"}},
+		{"nproc: 512\n      memlock:\n        soft: -1\n        hard: -1", map[string]string{
+			ulimitAnnotationPrefix + "nproc":   "512:512",
+			ulimitAnnotationPrefix + "memlock": "-1:-1",
+		}},
+	}
+	for _, test := range tests {
+		result := convertProject(t, `version: "2"
+services:
+  app:
+    image: app
+    ulimits:
+      `+test.ulimits+`
+`, nil, Options{})
+		rc := findObject(t, result, "ReplicationController", "app").Object.(*api.ReplicationController)
+		got := map[string]string{}
+		for key, value := range rc.Spec.Template.Annotations {
+			if strings.HasPrefix(key, ulimitAnnotationPrefix) {
+				got[key] = value
+			}
+		}
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%q: got annotations %v, want %v", test.ulimits, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2015 Kelsey Hightower All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package convert
+
+import (
+	"io/ioutil"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+)
+
+// fileObjectConfig is a top-level compose secret or config definition. Either
+// File names the file holding the content, or External marks an object that
+// already exists in the cluster.
+type fileObjectConfig struct {
+	File     string `yaml:"file"`
+	External bool   `yaml:"external"`
+	Name     string `yaml:"name"`
+}
+
+// fileObjectRef is a service reference to a secret or config, given either
+// as its name or in the long syntax.
+type fileObjectRef struct {
+	Source string `yaml:"source"`
+	Target string `yaml:"target"`
+	Mode   *int32 `yaml:"mode"`
+}
+
+// UnmarshalYAML accepts both the short and the long reference syntax.
+func (r *fileObjectRef) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var source string
+	if err := unmarshal(&source); err == nil {
+		r.Source = source
+		return nil
+	}
+	type plain fileObjectRef
+	return unmarshal((*plain)(r))
+}
+
+// targetPath returns the absolute path the reference is mounted at. Relative
+// targets, and the default of the source name, are placed in dir.
+func (r fileObjectRef) targetPath(dir string) string {
+	target := r.Target
+	if target == "" {
+		target = r.Source
+	}
+	if path.IsAbs(target) {
+		return target
+	}
+	return path.Join(dir, target)
+}
+
+// kubeName returns the Kubernetes object name for a compose object. External
+// objects keep their name as they must match an existing object.
+func kubeName(name string, config fileObjectConfig) string {
+	if config.Name != "" {
+		name = config.Name
+	}
+	if config.External {
+		return name
+	}
+	return strings.ToLower(strings.Replace(name, "_", "-", -1))
+}
+
+// readFileObject reads the content of a file-based secret or config.
+// Relative files are resolved against baseDir.
+func readFileObject(config fileObjectConfig, baseDir string) ([]byte, error) {
+	file := config.File
+	if !filepath.IsAbs(file) {
+		file = filepath.Join(baseDir, file)
+	}
+	return ioutil.ReadFile(file)
+}
+
+// newSecret creates a Secret holding the content of a file-based compose
+// secret under a key named after the secret.
+func newSecret(name string, config fileObjectConfig, baseDir string) (*api.Secret, error) {
+	data, err := readFileObject(config, baseDir)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.Secret{
+		TypeMeta: unversioned.TypeMeta{
+			Kind:       "Secret",
+			APIVersion: "v1",
+		},
+		ObjectMeta: api.ObjectMeta{
+			Name: kubeName(name, config),
+		},
+		Data: map[string][]byte{name: data},
+	}, nil
+}
+
+// mountFileObject adds a Secret or ConfigMap volume for source to the pod and
+// mounts the single key it projects at target in the first container.
+func mountFileObject(spec *api.PodSpec, volumeName, key, target string, source api.VolumeSource) {
+	spec.Volumes = append(spec.Volumes, api.Volume{Name: volumeName, VolumeSource: source})
+	spec.Containers[0].VolumeMounts = append(spec.Containers[0].VolumeMounts, api.VolumeMount{
+		Name:      volumeName,
+		ReadOnly:  true,
+		MountPath: target,
+		SubPath:   key,
+	})
+}
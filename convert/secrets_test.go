@@ -0,0 +1,72 @@
+/*
+Copyright 2015 Kelsey Hightower All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package convert
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/kubernetes/pkg/api"
+)
+
+func TestSecrets(t *testing.T) {
+	result := convertFixture(t, "secrets", Options{})
+
+	// Only the file secret is created, the external one must exist.
+	var secrets []string
+	for _, obj := range result.Objects {
+		if obj.Kind == "Secret" {
+			secrets = append(secrets, obj.Name)
+		}
+	}
+	if !reflect.DeepEqual(secrets, []string{"db-password"}) {
+		t.Fatalf("got secrets %q, want [db-password]", secrets)
+	}
+	secret := findObject(t, result, "Secret", "db-password").Object.(*api.Secret)
+	if got := string(secret.Data["db_password"]); got != "s3cr3t\n" {
+		t.Errorf("got secret data %q, want the content of the file", got)
+	}
+
+	spec := podSpec(t, result, "database")
+	wantVolumes := []api.Volume{
+		{
+			Name: "secret-db-password",
+			VolumeSource: api.VolumeSource{
+				Secret: &api.SecretVolumeSource{
+					SecretName: "db-password",
+					Items:      []api.KeyToPath{{Key: "db_password", Path: "db_password"}},
+				},
+			},
+		},
+		{
+			Name: "secret-tls-cert",
+			VolumeSource: api.VolumeSource{
+				Secret: &api.SecretVolumeSource{
+					SecretName: "tls_cert",
+					Items:      []api.KeyToPath{{Key: "tls_cert", Path: "tls_cert"}},
+				},
+			},
+		},
+	}
+	if !reflect.DeepEqual(spec.Volumes, wantVolumes) {
+		t.Errorf("got volumes %s, want %s", toJSON(spec.Volumes), toJSON(wantVolumes))
+	}
+	wantMounts := []api.VolumeMount{
+		{Name: "secret-db-password", ReadOnly: true, MountPath: "/run/secrets/db_password", SubPath: "db_password"},
+		{Name: "secret-tls-cert", ReadOnly: true, MountPath: "/run/secrets/server.crt", SubPath: "tls_cert"},
+	}
+	if got := spec.Containers[0].VolumeMounts; !reflect.DeepEqual(got, wantMounts) {
+		t.Errorf("got mounts %+v, want %+v", got, wantMounts)
+	}
+}
@@ -0,0 +1,94 @@
+/*
+Copyright 2015 Kelsey Hightower All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package convert
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"k8s.io/kubernetes/pkg/api"
+)
+
+// Labels configuring the security context of the pods of a service.
+const (
+	runAsNonRootLabel = "kompose.security.runAsNonRoot"
+	runAsUserLabel    = "kompose.security.runAsUser"
+	fsGroupLabel      = "kompose.security.fsGroup"
+	seccompLabel      = "kompose.security.seccomp"
+)
+
+// seccompPodAnnotation sets the seccomp profile of all the containers of a
+// pod. The API version used here has no seccomp field.
+const seccompPodAnnotation = "seccomp.security.alpha.kubernetes.io/pod"
+
+// Seccomp profile types accepted by the seccomp label. Localhost takes the
+// name of a profile on the node, as in Localhost/profile.json.
+const (
+	seccompRuntimeDefault = "RuntimeDefault"
+	seccompUnconfined     = "Unconfined"
+	seccompLocalhost      = "Localhost"
+)
+
+// podSecurityContext creates the security context of a pod from the
+// kompose.security labels, and returns the seccomp annotation value, if any.
+// It returns nil when no such label is set.
+func podSecurityContext(labels map[string]string) (*api.PodSecurityContext, string, error) {
+	var context api.PodSecurityContext
+	set := false
+	if value, ok := labels[runAsNonRootLabel]; ok {
+		nonRoot, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, "", fmt.Errorf("%s %q is not a boolean", runAsNonRootLabel, value)
+		}
+		context.RunAsNonRoot = &nonRoot
+		set = true
+	}
+	if value, ok := labels[runAsUserLabel]; ok {
+		user, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || user < 0 {
+			return nil, "", fmt.Errorf("%s %q is not a non-negative integer", runAsUserLabel, value)
+		}
+		context.RunAsUser = &user
+		set = true
+	}
+	if value, ok := labels[fsGroupLabel]; ok {
+		group, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || group < 0 {
+			return nil, "", fmt.Errorf("%s %q is not a non-negative integer", fsGroupLabel, value)
+		}
+		context.FSGroup = &group
+		set = true
+	}
+
+	seccomp := ""
+	if value, ok := labels[seccompLabel]; ok {
+		parts := strings.SplitN(value, "/", 2)
+		switch {
+		case value == seccompRuntimeDefault:
+			seccomp = "docker/default"
+		case value == seccompUnconfined:
+			seccomp = "unconfined"
+		case parts[0] == seccompLocalhost && len(parts) == 2 && parts[1] != "":
+			seccomp = "localhost/" + parts[1]
+		default:
+			return nil, "", fmt.Errorf("%s %q must be %s, %s or %s/<profile>", seccompLabel, value, seccompRuntimeDefault, seccompUnconfined, seccompLocalhost)
+		}
+	}
+
+	if !set {
+		return nil, seccomp, nil
+	}
+	return &context, seccomp, nil
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2015 Kelsey Hightower All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package convert
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/kubernetes/pkg/api"
+)
+
+func TestPodSecurityContext(t *testing.T) {
+	result := convertProject(t, `version: "2"
+services:
+  web:
+    image: nginx
+    labels:
+      kompose.security.runAsNonRoot: "true"
+      kompose.security.runAsUser: "1000"
+      kompose.security.fsGroup: "2000"
+      kompose.security.seccomp: RuntimeDefault
+  plain:
+    image: nginx
+`, nil, Options{})
+
+	rc := findObject(t, result, "ReplicationController", "web").Object.(*api.ReplicationController)
+	nonRoot, user, group := true, int64(1000), int64(2000)
+	want := &api.PodSecurityContext{RunAsNonRoot: &nonRoot, RunAsUser: &user, FSGroup: &group}
+	if got := rc.Spec.Template.Spec.SecurityContext; !reflect.DeepEqual(got, want) {
+		t.Errorf("got security context %s, want %s", toJSON(got), toJSON(want))
+	}
+	if got := rc.Spec.Template.Annotations[seccompPodAnnotation]; got != "docker/default" {
+		t.Errorf("got seccomp profile %q, want docker/default", got)
+	}
+
+	// Without the labels the pods keep the defaults of the cluster.
+	plain := findObject(t, result, "ReplicationController", "plain").Object.(*api.ReplicationController)
+	if plain.Spec.Template.Spec.SecurityContext != nil {
+		t.Errorf("got security context %s, want none", toJSON(plain.Spec.Template.Spec.SecurityContext))
+	}
+	if got, ok := plain.Spec.Template.Annotations[seccompPodAnnotation]; ok {
+		t.Errorf("got seccomp profile %q, want none", got)
+	}
+}
+
+func TestSeccompProfiles(t *testing.T) {
+	tests := map[string]string{
+		"RuntimeDefault":         "docker/default",
+		"Unconfined":             "unconfined",
+		"Localhost/profile.json": "localhost/profile.json",
+	}
+	for value, want := range tests {
+		context, seccomp, err := podSecurityContext(map[string]string{seccompLabel: value})
+		if err != nil {
+			t.Errorf("seccomp %s: %v", value, err)
+			continue
+		}
+		if context != nil || seccomp != want {
+			t.Errorf("seccomp %s: got context %s and profile %q, want %q only", value, toJSON(context), seccomp, want)
+		}
+	}
+}
+
+func TestPodSecurityContextInvalid(t *testing.T) {
+	for _, labels := range []map[string]string{
+		{runAsUserLabel: "nginx"},
+		{runAsUserLabel: "-1"},
+		{fsGroupLabel: "staff"},
+		{runAsNonRootLabel: "maybe"},
+		{seccompLabel: "docker/default"},
+		{seccompLabel: "Localhost/"},
+	} {
+		if _, _, err := podSecurityContext(labels); err == nil {
+			t.Errorf("podSecurityContext(%v) succeeded, want an error", labels)
+		}
+	}
+
+	_, err := Convert(writeProject(t, `version: "2"
+services:
+  web:
+    image: nginx
+    labels:
+      kompose.security.runAsUser: nginx
+`, nil), Options{})
+	if err == nil {
+		t.Error("converting a non-numeric user succeeded, want an error")
+	}
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2015 Kelsey Hightower All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package convert
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Pod annotations holding the sysctls of a pod as name=value lists. The API
+// version used here has no sysctls field.
+const (
+	sysctlsPodAnnotation       = "security.alpha.kubernetes.io/sysctls"
+	unsafeSysctlsPodAnnotation = "security.alpha.kubernetes.io/unsafe-sysctls"
+)
+
+// safeSysctls are the sysctls the kubelet allows by default, as they are
+// isolated between pods and cannot affect the node.
+var safeSysctls = map[string]bool{
+	"kernel.shm_rmid_forced":       true,
+	"net.ipv4.ip_local_port_range": true,
+	"net.ipv4.tcp_syncookies":      true,
+}
+
+// namespacedSysctlPrefixes are the prefixes of the sysctls set per network
+// or IPC namespace, which are the only ones a pod can set.
+var namespacedSysctlPrefixes = []string{
+	"fs.mqueue.",
+	"kernel.msg",
+	"kernel.sem",
+	"kernel.shm",
+	"net.",
+}
+
+// sysctlsConfig holds the sysctls of a service, given as a map or as a list
+// of name=value entries.
+type sysctlsConfig map[string]string
+
+// UnmarshalYAML decodes both forms of sysctls.
+func (s *sysctlsConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var entries []string
+	if err := unmarshal(&entries); err == nil {
+		*s = make(sysctlsConfig)
+		for _, entry := range entries {
+			parts := strings.SplitN(entry, "=", 2)
+			if len(parts) != 2 {
+				return fmt.Errorf("invalid sysctl %q, must be name=value", entry)
+			}
+			(*s)[strings.TrimSpace(parts[0])] = strings.TrimSpace(parts[1])
+		}
+		return nil
+	}
+	var values map[string]interface{}
+	if err := unmarshal(&values); err != nil {
+		return err
+	}
+	*s = make(sysctlsConfig)
+	for name, value := range values {
+		(*s)[name] = fmt.Sprint(value)
+	}
+	return nil
+}
+
+// namespacedSysctl reports whether the sysctl name can be set for a pod.
+func namespacedSysctl(name string) bool {
+	for _, prefix := range namespacedSysctlPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// sysctlAnnotations splits the sysctls that can be set for a pod into the
+// values of the safe and unsafe sysctls annotations, in name order, and
+// returns the sysctls of the node that cannot.
+func sysctlAnnotations(sysctls sysctlsConfig) (safe, unsafe string, node []string) {
+	names := make([]string, 0, len(sysctls))
+	for name := range sysctls {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var safeEntries, unsafeEntries []string
+	for _, name := range names {
+		entry := name + "=" + sysctls[name]
+		switch {
+		case safeSysctls[name]:
+			safeEntries = append(safeEntries, entry)
+		case namespacedSysctl(name):
+			unsafeEntries = append(unsafeEntries, entry)
+		default:
+			node = append(node, name)
+		}
+	}
+	return strings.Join(safeEntries, ","), strings.Join(unsafeEntries, ","), node
+}
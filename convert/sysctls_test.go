@@ -0,0 +1,73 @@
+/*
+Copyright 2015 Kelsey Hightower All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package convert
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/kubernetes/pkg/api"
+)
+
+func TestSysctls(t *testing.T) {
+	tests := []struct {
+		sysctls  string
+		safe     string
+		unsafe   string
+		skipped  []string
+		warnings int
+	}{
+		{"net.ipv4.tcp_syncookies: 0", "net.ipv4.tcp_syncookies=0", "", nil, 0},
+		{"- net.ipv4.tcp_syncookies=0\n      - kernel.shm_rmid_forced=1", "kernel.shm_rmid_forced=1,net.ipv4.tcp_syncookies=0", "", nil, 0},
+		{"net.core.somaxconn: 1024", "", "net.core.somaxconn=1024", nil, 1},
+		{"- kernel.msgmax=65536\n      - net.ipv4.ip_local_port_range=1024 65000",
+			"net.ipv4.ip_local_port_range=1024 65000", "kernel.msgmax=65536", nil, 1},
+		// Node sysctls cannot be set for a pod.
+		{"vm.swappiness: 10", "", "", []string{"sysctls"}, 1},
+	}
+	for _, test := range tests {
+		result := convertProject(t, `version: "2.1"
+services:
+  app:
+    image: app
+    sysctls:
+      `+test.sysctls+`
+`, nil, Options{})
+		annotations := findObject(t, result, "ReplicationController", "app").Object.(*api.ReplicationController).Spec.Template.Annotations
+		if got := annotations[sysctlsPodAnnotation]; got != test.safe {
+			t.Errorf("%q: got sysctls %q, want %q", test.sysctls, got, test.safe)
+		}
+		if got := annotations[unsafeSysctlsPodAnnotation]; got != test.unsafe {
+			t.Errorf("%q: got unsafe sysctls %q, want %q", test.sysctls, got, test.unsafe)
+		}
+		report := result.Report.Services["app"]
+		if !reflect.DeepEqual(report.Skipped, test.skipped) {
+			t.Errorf("%q: got skipped options %q, want %q", test.sysctls, report.Skipped, test.skipped)
+		}
+		if len(report.Warnings) != test.warnings {
+			t.Errorf("%q: got warnings %q, want %d", test.sysctls, report.Warnings, test.warnings)
+		}
+	}
+
+	_, err := Convert(writeProject(t, `version: "2.1"
+services:
+  app:
+    image: app
+    sysctls:
+      - net.core.somaxconn
+`, nil), Options{})
+	if err == nil {
+		t.Error("converting a sysctl without a value succeeded, want an error")
+	}
+}
@@ -0,0 +1,65 @@
+/*
+Copyright 2015 Kelsey Hightower All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package convert
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/kubernetes/pkg/api"
+)
+
+// tolerationsLabel lets the pods of a service tolerate node taints, as a
+// comma-separated list of key=value:Effect entries.
+const tolerationsLabel = "kompose.tolerations"
+
+// tolerations parses the tolerations of the kompose.tolerations label. An
+// entry without a value tolerates the taints with the key whatever their
+// value, and an entry without an effect tolerates every effect.
+func tolerations(labels map[string]string) ([]api.Toleration, error) {
+	value, ok := labels[tolerationsLabel]
+	if !ok {
+		return nil, nil
+	}
+	var result []api.Toleration
+	for _, entry := range strings.Split(value, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		var toleration api.Toleration
+		if i := strings.LastIndex(entry, ":"); i >= 0 {
+			toleration.Effect = api.TaintEffect(entry[i+1:])
+			entry = entry[:i]
+			switch toleration.Effect {
+			case api.TaintEffectNoSchedule, api.TaintEffectPreferNoSchedule:
+			default:
+				return nil, fmt.Errorf("invalid effect %q, must be %s or %s", toleration.Effect, api.TaintEffectNoSchedule, api.TaintEffectPreferNoSchedule)
+			}
+		}
+		parts := strings.SplitN(entry, "=", 2)
+		toleration.Key = parts[0]
+		if toleration.Key == "" {
+			return nil, fmt.Errorf("missing key in %q", entry)
+		}
+		if len(parts) == 2 {
+			toleration.Operator = api.TolerationOpEqual
+			toleration.Value = parts[1]
+		} else {
+			toleration.Operator = api.TolerationOpExists
+		}
+		result = append(result, toleration)
+	}
+	return result, nil
+}
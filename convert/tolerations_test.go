@@ -0,0 +1,71 @@
+/*
+Copyright 2015 Kelsey Hightower All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package convert
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"k8s.io/kubernetes/pkg/api"
+)
+
+func TestTolerations(t *testing.T) {
+	tests := []struct {
+		label string
+		want  []api.Toleration
+	}{
+		{"dedicated=gpu:NoSchedule", []api.Toleration{
+			{Key: "dedicated", Operator: api.TolerationOpEqual, Value: "gpu", Effect: api.TaintEffectNoSchedule},
+		}},
+		{"dedicated", []api.Toleration{
+			{Key: "dedicated", Operator: api.TolerationOpExists},
+		}},
+		{"spot:PreferNoSchedule, team=data", []api.Toleration{
+			{Key: "spot", Operator: api.TolerationOpExists, Effect: api.TaintEffectPreferNoSchedule},
+			{Key: "team", Operator: api.TolerationOpEqual, Value: "data"},
+		}},
+	}
+	for _, test := range tests {
+		result := convertProject(t, `version: "2"
+services:
+  app:
+    image: app
+    labels:
+      kompose.tolerations: "`+test.label+`"
+`, nil, Options{})
+		rc := findObject(t, result, "ReplicationController", "app").Object.(*api.ReplicationController)
+		var got []api.Toleration
+		if err := json.Unmarshal([]byte(rc.Spec.Template.Annotations[api.TolerationsAnnotationKey]), &got); err != nil {
+			t.Errorf("%q: invalid tolerations annotation: %v", test.label, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%q: got tolerations %s, want %s", test.label, toJSON(got), toJSON(test.want))
+		}
+	}
+
+	for _, label := range []string{"dedicated=gpu:NoExecute", "=gpu", ":NoSchedule"} {
+		_, err := Convert(writeProject(t, `version: "2"
+services:
+  app:
+    image: app
+    labels:
+      kompose.tolerations: "`+label+`"
+`, nil), Options{})
+		if err == nil {
+			t.Errorf("converting tolerations %q succeeded, want an error", label)
+		}
+	}
+}
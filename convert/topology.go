@@ -0,0 +1,89 @@
+/*
+Copyright 2015 Kelsey Hightower All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package convert
+
+import (
+	"fmt"
+	"strconv"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+)
+
+// hostnameTopologyKey is the node label holding the name of the node.
+const hostnameTopologyKey = "kubernetes.io/hostname"
+
+// Labels asking for the pods of a service to be spread over a topology.
+const (
+	topologyKeyLabel     = "kompose.topology.key"
+	topologyMaxSkewLabel = "kompose.topology.maxSkew"
+)
+
+// topologySpread spreads the pods matching selector over the node topology
+// named by the kompose.topology.key label. The API version used here predates
+// topology spread constraints, so the spread is approximated with a preferred
+// pod anti-affinity, which cannot bound the skew. The maxSkew label is only
+// validated. Nothing is spread with fewer than two replicas.
+func topologySpread(labels map[string]string, replicas int32, selector map[string]string) (*api.PodAntiAffinity, error) {
+	key, ok := labels[topologyKeyLabel]
+	if !ok {
+		if _, ok := labels[topologyMaxSkewLabel]; ok {
+			return nil, fmt.Errorf("%s requires the %s label", topologyMaxSkewLabel, topologyKeyLabel)
+		}
+		return nil, nil
+	}
+	if key == "" {
+		return nil, fmt.Errorf("empty %s label", topologyKeyLabel)
+	}
+	if value, ok := labels[topologyMaxSkewLabel]; ok {
+		if skew, err := strconv.Atoi(value); err != nil || skew <= 0 {
+			return nil, fmt.Errorf("%s %q is not a positive integer", topologyMaxSkewLabel, value)
+		}
+	}
+	if replicas <= 1 {
+		return nil, nil
+	}
+
+	return &api.PodAntiAffinity{
+		PreferredDuringSchedulingIgnoredDuringExecution: []api.WeightedPodAffinityTerm{
+			{
+				Weight: 100,
+				PodAffinityTerm: api.PodAffinityTerm{
+					LabelSelector: &unversioned.LabelSelector{MatchLabels: selector},
+					TopologyKey:   key,
+				},
+			},
+		},
+	}, nil
+}
+
+// colocation prefers the nodes running the pods of the services in deps. The
+// terms are preferred rather than required so that pods stay schedulable when
+// a node cannot fit them all.
+func colocation(deps []string) *api.PodAffinity {
+	if len(deps) == 0 {
+		return nil
+	}
+	affinity := &api.PodAffinity{}
+	for _, dep := range deps {
+		affinity.PreferredDuringSchedulingIgnoredDuringExecution = append(affinity.PreferredDuringSchedulingIgnoredDuringExecution, api.WeightedPodAffinityTerm{
+			Weight: 100,
+			PodAffinityTerm: api.PodAffinityTerm{
+				LabelSelector: &unversioned.LabelSelector{MatchLabels: map[string]string{"service": dep}},
+				TopologyKey:   hostnameTopologyKey,
+			},
+		})
+	}
+	return affinity
+}
@@ -0,0 +1,131 @@
+/*
+Copyright 2015 Kelsey Hightower All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package convert
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+)
+
+func TestTopologySpread(t *testing.T) {
+	result := convertProject(t, `version: "3"
+services:
+  web:
+    image: nginx
+    deploy:
+      replicas: 3
+    labels:
+      kompose.topology.key: topology.kubernetes.io/zone
+      kompose.topology.maxSkew: "1"
+  single:
+    image: nginx
+    labels:
+      kompose.topology.key: topology.kubernetes.io/zone
+`, nil, Options{})
+
+	// The pods of the service prefer the zones without any of them yet.
+	affinity := podAffinity(t, result, "web")
+	if affinity == nil || affinity.NodeAffinity != nil || affinity.PodAffinity != nil {
+		t.Fatalf("got affinity %s, want a pod anti-affinity only", toJSON(affinity))
+	}
+	want := &api.PodAntiAffinity{
+		PreferredDuringSchedulingIgnoredDuringExecution: []api.WeightedPodAffinityTerm{{
+			Weight: 100,
+			PodAffinityTerm: api.PodAffinityTerm{
+				LabelSelector: &unversioned.LabelSelector{MatchLabels: map[string]string{"service": "web"}},
+				TopologyKey:   "topology.kubernetes.io/zone",
+			},
+		}},
+	}
+	if !reflect.DeepEqual(affinity.PodAntiAffinity, want) {
+		t.Errorf("got pod anti-affinity %s, want %s", toJSON(affinity.PodAntiAffinity), toJSON(want))
+	}
+
+	// A single pod has nothing to be spread from.
+	if affinity := podAffinity(t, result, "single"); affinity != nil {
+		t.Errorf("got affinity %s for a single replica, want none", toJSON(affinity))
+	}
+
+	for _, labels := range []string{
+		"kompose.topology.maxSkew: \"1\"",
+		"kompose.topology.key: \"\"",
+		"kompose.topology.key: zone\n      kompose.topology.maxSkew: \"0\"",
+		"kompose.topology.key: zone\n      kompose.topology.maxSkew: one",
+	} {
+		_, err := Convert(writeProject(t, `version: "3"
+services:
+  web:
+    image: nginx
+    deploy:
+      replicas: 3
+    labels:
+      `+labels+`
+`, nil), Options{})
+		if err == nil {
+			t.Errorf("converting %q succeeded, want an error", labels)
+		}
+	}
+}
+
+func TestColocateDependencies(t *testing.T) {
+	const compose = `version: "2"
+services:
+  web:
+    image: nginx
+    depends_on:
+      - api
+      - cache
+  api:
+    image: api
+  cache:
+    image: redis
+`
+	result := convertProject(t, compose, nil, Options{ColocateDependencies: true, NamePrefix: "shop-"})
+
+	// The pods prefer the nodes of each dependency, selected by its
+	// prefixed service label.
+	affinity := podAffinity(t, result, "shop-web")
+	if affinity == nil || affinity.PodAffinity == nil {
+		t.Fatalf("got affinity %s, want a pod affinity", toJSON(affinity))
+	}
+	var want []api.WeightedPodAffinityTerm
+	for _, dep := range []string{"shop-api", "shop-cache"} {
+		want = append(want, api.WeightedPodAffinityTerm{
+			Weight: 100,
+			PodAffinityTerm: api.PodAffinityTerm{
+				LabelSelector: &unversioned.LabelSelector{MatchLabels: map[string]string{"service": dep}},
+				TopologyKey:   hostnameTopologyKey,
+			},
+		})
+	}
+	if got := affinity.PodAffinity.PreferredDuringSchedulingIgnoredDuringExecution; !reflect.DeepEqual(got, want) {
+		t.Errorf("got preferred terms %s, want %s", toJSON(got), toJSON(want))
+	}
+	if affinity.PodAffinity.RequiredDuringSchedulingIgnoredDuringExecution != nil {
+		t.Errorf("got required terms %s, want none", toJSON(affinity.PodAffinity.RequiredDuringSchedulingIgnoredDuringExecution))
+	}
+
+	// Services without dependencies, or converted without the option, get
+	// no affinity.
+	if affinity := podAffinity(t, result, "shop-api"); affinity != nil {
+		t.Errorf("got affinity %s for a service without dependencies, want none", toJSON(affinity))
+	}
+	result = convertProject(t, compose, nil, Options{})
+	if affinity := podAffinity(t, result, "web"); affinity != nil {
+		t.Errorf("got affinity %s without -colocate-dependencies, want none", toJSON(affinity))
+	}
+}
@@ -0,0 +1,204 @@
+/*
+Copyright 2015 Kelsey Hightower All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package convert
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/docker/libcompose/config"
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/resource"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+)
+
+// serviceVolume is a service volume in the long syntax.
+type serviceVolume struct {
+	Type     string `yaml:"type"`
+	Source   string `yaml:"source"`
+	Target   string `yaml:"target"`
+	ReadOnly bool   `yaml:"read_only"`
+	Tmpfs    *struct {
+		Size interface{} `yaml:"size"`
+	} `yaml:"tmpfs"`
+}
+
+// shortSyntax returns the short syntax of a bind mount, so that bind mounts
+// in the long syntax are converted exactly like the equivalent short syntax,
+// with read_only: true standing for the ro mode.
+func (v serviceVolume) shortSyntax() string {
+	spec := v.Source + ":" + v.Target
+	if v.ReadOnly {
+		spec += ":ro"
+	}
+	return spec
+}
+
+// readOnlyMode reports whether the mode of a short syntax volume makes it
+// read only. The mode may combine several options, as in ro,z.
+func readOnlyMode(mode string) bool {
+	readOnly := false
+	for _, option := range strings.Split(mode, ",") {
+		switch option {
+		case "ro":
+			readOnly = true
+		case "rw":
+			readOnly = false
+		}
+	}
+	return readOnly
+}
+
+// isHostPath reports whether the source of a short syntax volume is a host
+// path rather than the name of a volume. Like Docker, paths are told apart
+// from names by their leading /, . or ~.
+func isHostPath(source string) bool {
+	return strings.HasPrefix(source, "/") || strings.HasPrefix(source, ".") || strings.HasPrefix(source, "~")
+}
+
+// serviceVolumes sorts the volumes of a service into bind mounts, in the
+// short syntax, and the named, anonymous and tmpfs volumes, in the long
+// syntax. Bind mounts in the long syntax are handled like those in the short
+// syntax, and named and anonymous volumes in the short syntax like those in
+// the long syntax.
+func serviceVolumes(service *config.ServiceConfig, extras serviceExtras) ([]string, []serviceVolume) {
+	var binds []string
+	var volumes []serviceVolume
+	if service.Volumes != nil {
+		for _, volume := range service.Volumes.Volumes {
+			if volume.Source != "" && isHostPath(volume.Source) {
+				binds = append(binds, volume.String())
+				continue
+			}
+			volumes = append(volumes, serviceVolume{
+				Type:     "volume",
+				Source:   volume.Source,
+				Target:   volume.Destination,
+				ReadOnly: readOnlyMode(volume.AccessMode),
+			})
+		}
+	}
+	for _, volume := range extras.Volumes {
+		if volume.Type == "bind" {
+			binds = append(binds, volume.shortSyntax())
+			continue
+		}
+		volumes = append(volumes, volume)
+	}
+	return binds, volumes
+}
+
+// defaultClaimSize is the storage requested for the volumes of the compose
+// file, which cannot declare a size.
+var defaultClaimSize = resource.MustParse("100Mi")
+
+// claimName returns the name of the claim backing a named volume.
+func claimName(volume string) string {
+	return strings.Trim(invalidNameChars.ReplaceAllString(strings.ToLower(volume), "-"), "-")
+}
+
+// newClaim creates the claim of the given name.
+func newClaim(name string) *api.PersistentVolumeClaim {
+	return &api.PersistentVolumeClaim{
+		TypeMeta: unversioned.TypeMeta{
+			Kind:       "PersistentVolumeClaim",
+			APIVersion: "v1",
+		},
+		ObjectMeta: api.ObjectMeta{
+			Name: name,
+		},
+		Spec: api.PersistentVolumeClaimSpec{
+			AccessModes: []api.PersistentVolumeAccessMode{api.ReadWriteOnce},
+			Resources: api.ResourceRequirements{
+				Requests: api.ResourceList{api.ResourceStorage: defaultClaimSize},
+			},
+		},
+	}
+}
+
+// resolveHostPath turns a relative bind mount source into an absolute path.
+// Paths starting with . are relative to baseDir, the directory of the compose
+// file, and paths starting with ~ are relative to the home directory. Other
+// paths are returned unchanged.
+func resolveHostPath(path, baseDir string) (string, error) {
+	switch {
+	case path == "~" || strings.HasPrefix(path, "~/"):
+		return filepath.Join(os.Getenv("HOME"), path[1:]), nil
+	case strings.HasPrefix(path, "."):
+		return filepath.Abs(filepath.Join(baseDir, path))
+	}
+	return path, nil
+}
+
+// invalidNameChars matches the characters not allowed in object names.
+var invalidNameChars = regexp.MustCompile(`[^a-z0-9-]+`)
+
+// maxVolumeNameLength is the maximum length of a volume name, which must be
+// a DNS label.
+const maxVolumeNameLength = 63
+
+// hostPathVolumeName returns the name of the volume mounting the host path.
+// The path is made readable and suffixed with a hash of the full path, so
+// that paths differing only in the characters dropped or in the part cut to
+// fit the length limit get distinct names.
+func hostPathVolumeName(path string) string {
+	hash := fmt.Sprintf("%x", sha256.Sum256([]byte(path)))[:10]
+	name := strings.Trim(invalidNameChars.ReplaceAllString(strings.ToLower(path), "-"), "-")
+	if name == "" {
+		name = "host"
+	}
+	if max := maxVolumeNameLength - len(hash) - 1; len(name) > max {
+		name = strings.TrimRight(name[:max], "-")
+	}
+	return name + "-" + hash
+}
+
+// newFileConfigMap creates a ConfigMap holding the content of the file at
+// path, to be mounted in place of a bind mount of service. The file is stored
+// under a key named after it. index is the number of file ConfigMaps already
+// created for service, which is appended to the name to keep it unique. No
+// ConfigMap is created when path is not a regular file.
+func newFileConfigMap(service, path string, index int) (*api.ConfigMap, string, error) {
+	info, err := os.Stat(path)
+	if err != nil || !info.Mode().IsRegular() {
+		return nil, "", nil
+	}
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, "", err
+	}
+
+	key := filepath.Base(path)
+	name := strings.Trim(invalidNameChars.ReplaceAllString(strings.ToLower(key), "-"), "-")
+	name = service + "-" + name
+	if index > 0 {
+		name = fmt.Sprintf("%s-%d", name, index)
+	}
+	return &api.ConfigMap{
+		TypeMeta: unversioned.TypeMeta{
+			Kind:       "ConfigMap",
+			APIVersion: "v1",
+		},
+		ObjectMeta: api.ObjectMeta{
+			Name:   name,
+			Labels: map[string]string{"service": service},
+		},
+		Data: map[string]string{key: string(data)},
+	}, key, nil
+}
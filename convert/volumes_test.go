@@ -0,0 +1,378 @@
+/*
+Copyright 2015 Kelsey Hightower All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package convert
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"k8s.io/kubernetes/pkg/api"
+)
+
+func TestResolveHostPath(t *testing.T) {
+	home := os.Getenv("HOME")
+	tests := []struct {
+		path string
+		want string
+	}{
+		{"./config", "/srv/app/config"},
+		{"../shared", "/srv/shared"},
+		{".", "/srv/app"},
+		{"/etc/app", "/etc/app"},
+		{"~/data", filepath.Join(home, "data")},
+	}
+	for _, test := range tests {
+		got, err := resolveHostPath(test.path, "/srv/app")
+		if err != nil {
+			t.Errorf("resolveHostPath(%q): %v", test.path, err)
+			continue
+		}
+		if got != test.want {
+			t.Errorf("resolveHostPath(%q) = %q, want %q", test.path, got, test.want)
+		}
+	}
+}
+
+func TestRelativeHostPath(t *testing.T) {
+	composeFile := writeProject(t, `version: "2"
+services:
+  web:
+    image: nginx
+    volumes:
+      - ./config:/etc/app
+      - /var/log:/var/log/app
+`, nil)
+	result, err := Convert(composeFile, Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	spec := podSpec(t, result, "web")
+	want := []string{filepath.Join(filepath.Dir(composeFile), "config"), "/var/log"}
+	if len(spec.Volumes) != len(want) {
+		t.Fatalf("got volumes %s, want host paths %q", toJSON(spec.Volumes), want)
+	}
+	for i, volume := range spec.Volumes {
+		if volume.HostPath == nil || volume.HostPath.Path != want[i] {
+			t.Errorf("got volume %s, want host path %s", toJSON(volume), want[i])
+		}
+	}
+}
+
+func TestConfigMapFiles(t *testing.T) {
+	result := convertProject(t, `version: "2"
+services:
+  web:
+    image: nginx
+    volumes:
+      - ./nginx.conf:/etc/nginx/nginx.conf:ro
+      - ./site.conf:/etc/nginx/conf.d/site.conf
+      - ./html:/usr/share/nginx/html:ro
+`, map[string]string{
+		"nginx.conf":      "worker_processes 1;\n",
+		"site.conf":       "server {}\n",
+		"html/index.html": "<h1>hello</h1>\n",
+	}, Options{ConfigMapFiles: true})
+
+	// Only the read-only file becomes a config map.
+	configMap := findObject(t, result, "ConfigMap", "web-nginx-conf").Object.(*api.ConfigMap)
+	if want := map[string]string{"nginx.conf": "worker_processes 1;\n"}; !reflect.DeepEqual(configMap.Data, want) {
+		t.Errorf("got config map data %q, want %q", configMap.Data, want)
+	}
+	for _, obj := range result.Objects {
+		if obj.Kind == "ConfigMap" && obj.Name != configMap.Name {
+			t.Errorf("got unexpected config map %s", obj.Name)
+		}
+	}
+
+	spec := podSpec(t, result, "web")
+	volume := spec.Volumes[0]
+	if volume.Name != configMap.Name || volume.ConfigMap == nil || volume.ConfigMap.Name != configMap.Name {
+		t.Errorf("got volume %s, want config map %s", toJSON(volume), configMap.Name)
+	}
+	wantMount := api.VolumeMount{Name: configMap.Name, ReadOnly: true, MountPath: "/etc/nginx/nginx.conf", SubPath: "nginx.conf"}
+	if got := spec.Containers[0].VolumeMounts[0]; got != wantMount {
+		t.Errorf("got mount %s, want %s", toJSON(got), toJSON(wantMount))
+	}
+
+	// The writable file and the directory stay host paths.
+	for _, volume := range spec.Volumes[1:] {
+		if volume.HostPath == nil {
+			t.Errorf("got volume %s, want a host path", toJSON(volume))
+		}
+	}
+}
+
+func TestVolumeTypes(t *testing.T) {
+	result := convertFixture(t, "volumes", Options{})
+
+	// Each named volume gets a single claim.
+	var claims []string
+	for _, obj := range result.Objects {
+		if obj.Kind == "PersistentVolumeClaim" {
+			claims = append(claims, obj.Name)
+		}
+	}
+	if want := []string{"dbdata", "uploads"}; !reflect.DeepEqual(claims, want) {
+		t.Errorf("got claims %q, want %q", claims, want)
+	}
+
+	html, err := filepath.Abs(filepath.Join("testdata", "volumes", "html"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	web := podSpec(t, result, "web")
+	wantVolumes := []api.Volume{
+		{Name: hostPathVolumeName(html), VolumeSource: api.VolumeSource{HostPath: &api.HostPathVolumeSource{Path: html}}},
+		{Name: "uploads", VolumeSource: api.VolumeSource{PersistentVolumeClaim: &api.PersistentVolumeClaimVolumeSource{ClaimName: "uploads"}}},
+		{Name: "tmpfs-1", VolumeSource: api.VolumeSource{EmptyDir: &api.EmptyDirVolumeSource{Medium: api.StorageMediumMemory}}},
+		{Name: "anonymous-2", VolumeSource: api.VolumeSource{EmptyDir: &api.EmptyDirVolumeSource{}}},
+	}
+	if !reflect.DeepEqual(web.Volumes, wantVolumes) {
+		t.Errorf("web: got volumes %s, want %s", toJSON(web.Volumes), toJSON(wantVolumes))
+	}
+	wantMounts := []api.VolumeMount{
+		{Name: hostPathVolumeName(html), ReadOnly: true, MountPath: "/usr/share/nginx/html"},
+		{Name: "uploads", MountPath: "/usr/share/nginx/uploads"},
+		{Name: "tmpfs-1", MountPath: "/var/cache/nginx"},
+		{Name: "anonymous-2", MountPath: "/scratch"},
+	}
+	if got := web.Containers[0].VolumeMounts; !reflect.DeepEqual(got, wantMounts) {
+		t.Errorf("web: got mounts %s, want %s", toJSON(got), toJSON(wantMounts))
+	}
+
+	// Named and anonymous volumes in the short syntax are not host paths.
+	database := podSpec(t, result, "database")
+	wantVolumes = []api.Volume{
+		{Name: "dbdata", VolumeSource: api.VolumeSource{PersistentVolumeClaim: &api.PersistentVolumeClaimVolumeSource{ClaimName: "dbdata"}}},
+		{Name: "anonymous-2", VolumeSource: api.VolumeSource{EmptyDir: &api.EmptyDirVolumeSource{}}},
+		{Name: "uploads", VolumeSource: api.VolumeSource{PersistentVolumeClaim: &api.PersistentVolumeClaimVolumeSource{ClaimName: "uploads", ReadOnly: true}}},
+	}
+	if !reflect.DeepEqual(database.Volumes, wantVolumes) {
+		t.Errorf("database: got volumes %s, want %s", toJSON(database.Volumes), toJSON(wantVolumes))
+	}
+	wantMounts = []api.VolumeMount{
+		{Name: "dbdata", MountPath: "/var/lib/postgresql/data"},
+		{Name: "dbdata", ReadOnly: true, MountPath: "/backup"},
+		{Name: "anonymous-2", MountPath: "/var/run/postgresql"},
+		{Name: "uploads", ReadOnly: true, MountPath: "/uploads"},
+	}
+	if got := database.Containers[0].VolumeMounts; !reflect.DeepEqual(got, wantMounts) {
+		t.Errorf("database: got mounts %s, want %s", toJSON(got), toJSON(wantMounts))
+	}
+}
+
+func TestInvalidBindMount(t *testing.T) {
+	_, err := Convert(writeProject(t, `version: "3.4"
+services:
+  web:
+    image: nginx
+    volumes:
+      - type: bind
+        target: /usr/share/nginx/html
+`, nil), Options{})
+	if err == nil {
+		t.Error("converting a bind mount without a source succeeded, want an error")
+	}
+}
+
+func TestMissingHostPath(t *testing.T) {
+	result := convertProject(t, `version: "2"
+services:
+  web:
+    image: nginx
+    volumes:
+      - ./html:/usr/share/nginx/html
+      - ./nginx.conf:/etc/nginx/nginx.conf
+      - ./missing:/var/cache/nginx
+      - ./missing:/tmp/cache
+`, map[string]string{
+		"html/index.html": "<h1>hello</h1>\n",
+		"nginx.conf":      "worker_processes 1;\n",
+	}, Options{})
+
+	// Only the missing path is reported, once.
+	warnings := result.Report.Services["web"].Warnings
+	if len(warnings) != 1 || !strings.Contains(warnings[0], "/missing of service web does not exist") {
+		t.Errorf("got warnings %q, want one for the missing path", warnings)
+	}
+}
+
+func TestAbsoluteHostPathStrict(t *testing.T) {
+	// Absolute paths are paths of the nodes, which the conversion cannot
+	// check, so they do not fail a strict conversion.
+	composeFile := writeProject(t, `version: "2"
+services:
+  web:
+    image: nginx
+    volumes:
+      - /var/run/docker.sock:/var/run/docker.sock
+      - /compose2kube-missing/data:/data
+`, nil)
+	if _, err := Convert(composeFile, Options{Strict: true}); err != nil {
+		t.Errorf("Convert: %v", err)
+	}
+
+	composeFile = writeProject(t, `version: "2"
+services:
+  web:
+    image: nginx
+    volumes:
+      - ./missing:/data
+`, nil)
+	if _, err := Convert(composeFile, Options{Strict: true}); err == nil {
+		t.Errorf("got no error for a missing relative host path with Strict")
+	}
+}
+
+func TestReadOnly(t *testing.T) {
+	// Both compose files are in the same directory, so that they mount the
+	// same host path.
+	composeFile := writeProject(t, `version: "3.4"
+services:
+  web:
+    image: nginx
+    read_only: true
+    volumes:
+      - ./html:/usr/share/nginx/html:ro,z
+      - uploads:/uploads:ro
+`, map[string]string{
+		"html/index.html": "<h1>hello</h1>\n",
+		"long.yml": `version: "3.4"
+services:
+  web:
+    image: nginx
+    read_only: true
+    volumes:
+      - type: bind
+        source: ./html
+        target: /usr/share/nginx/html
+        read_only: true
+      - type: volume
+        source: uploads
+        target: /uploads
+        read_only: true
+volumes:
+  uploads:
+`,
+	})
+	short, err := Convert(composeFile, Options{})
+	if err != nil {
+		t.Fatalf("Convert: %v", err)
+	}
+	long, err := Convert(filepath.Join(filepath.Dir(composeFile), "long.yml"), Options{})
+	if err != nil {
+		t.Fatalf("Convert: %v", err)
+	}
+
+	// The short syntax and the long syntax give the same mounts and
+	// volumes, with the claim read only as well.
+	shortSpec, longSpec := podSpec(t, short, "web"), podSpec(t, long, "web")
+	if got, want := toJSON(shortSpec.Containers[0].VolumeMounts), toJSON(longSpec.Containers[0].VolumeMounts); got != want {
+		t.Errorf("got short syntax mounts %s, want the long syntax mounts %s", got, want)
+	}
+	for _, mount := range longSpec.Containers[0].VolumeMounts {
+		if !mount.ReadOnly {
+			t.Errorf("mount %s is writable", mount.MountPath)
+		}
+	}
+	if got, want := toJSON(shortSpec.Volumes), toJSON(longSpec.Volumes); got != want {
+		t.Errorf("got short syntax volumes %s, want the long syntax volumes %s", got, want)
+	}
+	for _, volume := range longSpec.Volumes {
+		if claim := volume.PersistentVolumeClaim; claim != nil && !claim.ReadOnly {
+			t.Errorf("claim %s is writable", claim.ClaimName)
+		}
+	}
+
+	// A read only service has a read only root filesystem.
+	for _, spec := range []*api.PodSpec{shortSpec, longSpec} {
+		context := spec.Containers[0].SecurityContext
+		if context == nil || context.ReadOnlyRootFilesystem == nil || !*context.ReadOnlyRootFilesystem {
+			t.Errorf("got security context %s, want a read only root filesystem", toJSON(context))
+		}
+	}
+	writable := convertProject(t, `version: "2"
+services:
+  web:
+    image: nginx
+`, nil, Options{})
+	if context := podSpec(t, writable, "web").Containers[0].SecurityContext; context != nil {
+		t.Errorf("got security context %s for a writable service", toJSON(context))
+	}
+}
+
+func TestTmpfs(t *testing.T) {
+	tests := []struct {
+		tmpfs    string
+		readOnly bool
+		skipped  int
+	}{
+		{"/tmp", false, 0},
+		{"/run:size=64m", false, 1},
+		{"/run:ro", true, 0},
+		{"/run:rw,noexec", false, 1},
+		{"/run:size=1g,mode=1777,ro", true, 2},
+	}
+	for _, test := range tests {
+		result := convertProject(t, `version: "2"
+services:
+  web:
+    image: nginx
+    tmpfs:
+      - `+test.tmpfs+`
+`, nil, Options{})
+
+		spec := podSpec(t, result, "web")
+		wantVolumes := []api.Volume{{
+			Name:         "tmpfs-0",
+			VolumeSource: api.VolumeSource{EmptyDir: &api.EmptyDirVolumeSource{Medium: api.StorageMediumMemory}},
+		}}
+		if !reflect.DeepEqual(spec.Volumes, wantVolumes) {
+			t.Errorf("%s: got volumes %s, want %s", test.tmpfs, toJSON(spec.Volumes), toJSON(wantVolumes))
+		}
+		path := strings.SplitN(test.tmpfs, ":", 2)[0]
+		wantMounts := []api.VolumeMount{{Name: "tmpfs-0", MountPath: path, ReadOnly: test.readOnly}}
+		if got := spec.Containers[0].VolumeMounts; !reflect.DeepEqual(got, wantMounts) {
+			t.Errorf("%s: got mounts %s, want %s", test.tmpfs, toJSON(got), toJSON(wantMounts))
+		}
+		if got := len(result.Report.Services["web"].Skipped); got != test.skipped {
+			t.Errorf("%s: got %d skipped options, want %d", test.tmpfs, got, test.skipped)
+		}
+	}
+}
+
+func TestInvalidTmpfs(t *testing.T) {
+	for _, tmpfs := range []string{
+		"/run:size=lots",
+		"/run:size=",
+		"/run:mode=rwx",
+		"/run:mode=99",
+		"/run:mode=17777",
+	} {
+		composeFile := writeProject(t, `version: "2"
+services:
+  web:
+    image: nginx
+    tmpfs:
+      - `+tmpfs+`
+`, nil)
+		if _, err := Convert(composeFile, Options{}); err == nil || !strings.Contains(err.Error(), "invalid tmpfs") {
+			t.Errorf("%s: got error %v, want an invalid tmpfs error", tmpfs, err)
+		}
+	}
+}
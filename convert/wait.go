@@ -0,0 +1,56 @@
+/*
+Copyright 2015 Kelsey Hightower All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package convert
+
+import (
+	"fmt"
+
+	"k8s.io/kubernetes/pkg/api"
+)
+
+// Conditions of the long form of depends_on.
+const (
+	conditionStarted   = "service_started"
+	conditionHealthy   = "service_healthy"
+	conditionCompleted = "service_completed_successfully"
+)
+
+// dependsOnConfig is an entry of the long form of depends_on.
+type dependsOnConfig struct {
+	Condition string `yaml:"condition"`
+}
+
+// initContainersAnnotation holds the init containers of a pod in this API
+// version.
+const initContainersAnnotation = "pod.beta.kubernetes.io/init-containers"
+
+// waitImage is the image of the init containers waiting for dependencies.
+const waitImage = "busybox"
+
+// newDependencyWait creates an init container waiting for the dependency dep
+// reachable at host. With a port, it waits until a connection to the port
+// succeeds, which a service only accepts once it has ready endpoints.
+// Without one, it waits until host resolves.
+func newDependencyWait(dep, host string, port int32) api.Container {
+	check := fmt.Sprintf("nslookup %s", host)
+	if port != 0 {
+		check = fmt.Sprintf("nc -z -w 2 %s %d", host, port)
+	}
+	script := fmt.Sprintf("until %s; do echo waiting for %s; sleep 2; done", check, dep)
+	return api.Container{
+		Name:    "wait-for-" + host,
+		Image:   waitImage,
+		Command: []string{"/bin/sh", "-c", script},
+	}
+}
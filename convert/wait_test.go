@@ -0,0 +1,74 @@
+/*
+Copyright 2015 Kelsey Hightower All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package convert
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"k8s.io/kubernetes/pkg/api"
+)
+
+func TestWaitForDependencies(t *testing.T) {
+	result := convertFixture(t, "wait", Options{WaitForDependencies: true})
+
+	var waits []api.Container
+	rc := findObject(t, result, "ReplicationController", "web").Object.(*api.ReplicationController)
+	if err := json.Unmarshal([]byte(rc.Spec.Template.Annotations[initContainersAnnotation]), &waits); err != nil {
+		t.Fatalf("invalid init containers annotation: %v", err)
+	}
+	var scripts []string
+	for _, wait := range waits {
+		scripts = append(scripts, wait.Command[2])
+	}
+	// The dependencies are waited for in the order they are declared.
+	want := []string{
+		"until nc -z -w 2 database 5432; do echo waiting for database; sleep 2; done",
+		"until nslookup cache; do echo waiting for cache; sleep 2; done",
+	}
+	if !reflect.DeepEqual(scripts, want) {
+		t.Errorf("got init container scripts %q, want %q", scripts, want)
+	}
+	if got, want := result.Report.Services["web"].Skipped, []string{"depends_on", "depends_on"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got skipped options %q, want %q", got, want)
+	}
+
+	// The services waited for resolve through a headless service, the
+	// others get none.
+	for _, name := range []string{"database", "cache"} {
+		service := findObject(t, result, "Service", name).Object.(*api.Service)
+		if service.Spec.ClusterIP != api.ClusterIPNone {
+			t.Errorf("%s: got cluster IP %q, want a headless service", name, service.Spec.ClusterIP)
+		}
+	}
+	for _, obj := range result.Objects {
+		if obj.Kind == "Service" && (obj.Name == "migrate" || obj.Name == "sidecar" || obj.Name == "web") {
+			t.Errorf("got service %s, which nothing waits for", obj.Name)
+		}
+	}
+}
+
+func TestNoWaitForDependencies(t *testing.T) {
+	result := convertFixture(t, "wait", Options{})
+	for _, obj := range result.Objects {
+		if obj.Kind == "Service" {
+			t.Errorf("got service %s without WaitForDependencies", obj.Name)
+		}
+	}
+	rc := findObject(t, result, "ReplicationController", "web").Object.(*api.ReplicationController)
+	if _, ok := rc.Spec.Template.Annotations[initContainersAnnotation]; ok {
+		t.Errorf("got init containers without WaitForDependencies")
+	}
+}
@@ -0,0 +1,134 @@
+/*
+Copyright 2015 Kelsey Hightower All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package convert
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/apis/extensions"
+	"k8s.io/kubernetes/pkg/util/intstr"
+)
+
+// Labels selecting the web app pattern for a service and the host it is
+// exposed at.
+const (
+	serviceKindLabel  = "kompose.service.kind"
+	serviceKindWebApp = "webapp"
+	exposeLabel       = "kompose.service.expose"
+)
+
+// headlessLabel asks for the service of a workload to be headless, resolving
+// to the addresses of its pods rather than to a cluster IP.
+const headlessLabel = "kompose.service.headless"
+
+// newService creates a ClusterIP service named after rc in front of its pods,
+// forwarding the given container ports. A headless service has no cluster IP.
+func newService(name string, rc *api.ReplicationController, ports []api.ContainerPort, headless bool) Object {
+	service := &api.Service{
+		TypeMeta: unversioned.TypeMeta{
+			Kind:       "Service",
+			APIVersion: "v1",
+		},
+		ObjectMeta: api.ObjectMeta{
+			Name:   rc.Name,
+			Labels: rc.Labels,
+		},
+		Spec: api.ServiceSpec{
+			Type:     api.ServiceTypeClusterIP,
+			Selector: rc.Spec.Selector,
+		},
+	}
+	if headless {
+		service.Spec.ClusterIP = api.ClusterIPNone
+	}
+	for _, port := range ports {
+		protocol := port.Protocol
+		if protocol == "" {
+			protocol = api.ProtocolTCP
+		}
+		servicePort := api.ServicePort{
+			Protocol:   protocol,
+			Port:       port.ContainerPort,
+			TargetPort: intstr.FromInt(int(port.ContainerPort)),
+		}
+		// Services with several ports must name each of them.
+		if len(ports) > 1 {
+			servicePort.Name = fmt.Sprintf("%s-%d", strings.ToLower(string(protocol)), port.ContainerPort)
+		}
+		service.Spec.Ports = append(service.Spec.Ports, servicePort)
+	}
+	return Object{
+		Object:   service,
+		Service:  name,
+		Kind:     service.Kind,
+		Name:     service.Name,
+		BaseName: service.Name + "-svc",
+	}
+}
+
+// newWebApp creates the service in front of the pods of rc, and an ingress
+// routing host to it when host is set. Both use the first container port.
+func newWebApp(name string, rc *api.ReplicationController, host string, headless bool) ([]Object, error) {
+	ports := rc.Spec.Template.Spec.Containers[0].Ports
+	if len(ports) == 0 {
+		return nil, fmt.Errorf("no ports to serve on")
+	}
+	port := ports[0].ContainerPort
+
+	objects := []Object{newService(name, rc, ports[:1], headless)}
+	if host == "" {
+		return objects, nil
+	}
+
+	ingress := &extensions.Ingress{
+		TypeMeta: unversioned.TypeMeta{
+			Kind:       "Ingress",
+			APIVersion: "extensions/v1beta1",
+		},
+		ObjectMeta: api.ObjectMeta{
+			Name:   rc.Name,
+			Labels: rc.Labels,
+		},
+		Spec: extensions.IngressSpec{
+			Rules: []extensions.IngressRule{
+				{
+					Host: host,
+					IngressRuleValue: extensions.IngressRuleValue{
+						HTTP: &extensions.HTTPIngressRuleValue{
+							Paths: []extensions.HTTPIngressPath{
+								{
+									Path: "/",
+									Backend: extensions.IngressBackend{
+										ServiceName: rc.Name,
+										ServicePort: intstr.FromInt(int(port)),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	return append(objects, Object{
+		Object:   ingress,
+		Service:  name,
+		Kind:     ingress.Kind,
+		Name:     ingress.Name,
+		BaseName: ingress.Name + "-ingress",
+	}), nil
+}
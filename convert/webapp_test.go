@@ -0,0 +1,183 @@
+/*
+Copyright 2015 Kelsey Hightower All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package convert
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/apis/extensions"
+	"k8s.io/kubernetes/pkg/util/intstr"
+)
+
+func TestWebApp(t *testing.T) {
+	result := convertProject(t, `version: "2"
+services:
+  web:
+    image: nginx
+    ports:
+      - "8080:80"
+      - "443"
+    labels:
+      kompose.service.kind: webapp
+      kompose.service.expose: shop.example.com
+`, nil, Options{})
+
+	var kinds []string
+	for _, obj := range result.Objects {
+		kinds = append(kinds, obj.Kind)
+	}
+	if want := []string{"Service", "Ingress", "Deployment"}; !reflect.DeepEqual(kinds, want) {
+		t.Fatalf("got objects of kinds %q, want %q", kinds, want)
+	}
+
+	// The web app runs as a deployment whatever the controller option.
+	deployment := findObject(t, result, "Deployment", "web").Object.(*extensions.Deployment)
+	selector := map[string]string{"service": "web"}
+	if deployment.Spec.Selector == nil || !reflect.DeepEqual(deployment.Spec.Selector.MatchLabels, selector) {
+		t.Errorf("got deployment selector %s, want %v", toJSON(deployment.Spec.Selector), selector)
+	}
+
+	// The service forwards the first container port to the pods.
+	service := findObject(t, result, "Service", "web").Object.(*api.Service)
+	if service.Spec.Type != api.ServiceTypeClusterIP || service.Spec.ClusterIP != "" {
+		t.Errorf("got service type %s, cluster IP %q, want a ClusterIP service", service.Spec.Type, service.Spec.ClusterIP)
+	}
+	if !reflect.DeepEqual(service.Spec.Selector, selector) {
+		t.Errorf("got service selector %v, want %v", service.Spec.Selector, selector)
+	}
+	wantPorts := []api.ServicePort{{Protocol: api.ProtocolTCP, Port: 80, TargetPort: intstr.FromInt(80)}}
+	if !reflect.DeepEqual(service.Spec.Ports, wantPorts) {
+		t.Errorf("got service ports %s, want %s", toJSON(service.Spec.Ports), toJSON(wantPorts))
+	}
+
+	// The ingress routes the exposed host to the service.
+	ingress := findObject(t, result, "Ingress", "web").Object.(*extensions.Ingress)
+	wantRules := []extensions.IngressRule{{
+		Host: "shop.example.com",
+		IngressRuleValue: extensions.IngressRuleValue{
+			HTTP: &extensions.HTTPIngressRuleValue{
+				Paths: []extensions.HTTPIngressPath{{
+					Path:    "/",
+					Backend: extensions.IngressBackend{ServiceName: service.Name, ServicePort: intstr.FromInt(80)},
+				}},
+			},
+		},
+	}}
+	if !reflect.DeepEqual(ingress.Spec.Rules, wantRules) {
+		t.Errorf("got ingress rules %s, want %s", toJSON(ingress.Spec.Rules), toJSON(wantRules))
+	}
+}
+
+func TestWebAppWithoutHost(t *testing.T) {
+	result := convertProject(t, `version: "2"
+services:
+  web:
+    image: nginx
+    ports:
+      - "80"
+    labels:
+      kompose.service.kind: webapp
+`, nil, Options{Controller: ControllerRC})
+
+	var kinds []string
+	for _, obj := range result.Objects {
+		kinds = append(kinds, obj.Kind)
+	}
+	if want := []string{"Service", "Deployment"}; !reflect.DeepEqual(kinds, want) {
+		t.Errorf("got objects of kinds %q, want %q", kinds, want)
+	}
+
+	for _, service := range []string{
+		"labels:\n      kompose.service.kind: webapp",
+		"ports:\n      - \"80\"\n    labels:\n      kompose.service.kind: website",
+	} {
+		_, err := Convert(writeProject(t, `version: "2"
+services:
+  web:
+    image: nginx
+    `+service+`
+`, nil), Options{})
+		if err == nil {
+			t.Errorf("converting %q succeeded, want an error", service)
+		}
+	}
+}
+
+func TestHeadless(t *testing.T) {
+	result := convertProject(t, `version: "2"
+services:
+  db:
+    image: postgres
+    ports:
+      - "5432"
+      - "8008"
+    labels:
+      kompose.service.headless: "true"
+  web:
+    image: nginx
+    ports:
+      - "80"
+    labels:
+      kompose.service.kind: webapp
+      kompose.service.headless: "true"
+  cache:
+    image: redis
+    ports:
+      - "6379"
+    labels:
+      kompose.service.headless: "false"
+`, nil, Options{})
+
+	// A workload gets a headless service for all its ports.
+	db := findObject(t, result, "Service", "db").Object.(*api.Service)
+	if db.Spec.ClusterIP != api.ClusterIPNone {
+		t.Errorf("got cluster IP %q, want a headless service", db.Spec.ClusterIP)
+	}
+	if want := map[string]string{"service": "db"}; !reflect.DeepEqual(db.Spec.Selector, want) {
+		t.Errorf("got selector %v, want %v", db.Spec.Selector, want)
+	}
+	wantPorts := []api.ServicePort{
+		{Name: "tcp-5432", Protocol: api.ProtocolTCP, Port: 5432, TargetPort: intstr.FromInt(5432)},
+		{Name: "tcp-8008", Protocol: api.ProtocolTCP, Port: 8008, TargetPort: intstr.FromInt(8008)},
+	}
+	if !reflect.DeepEqual(db.Spec.Ports, wantPorts) {
+		t.Errorf("got ports %s, want %s", toJSON(db.Spec.Ports), toJSON(wantPorts))
+	}
+
+	// The service of a web app can be headless too.
+	web := findObject(t, result, "Service", "web").Object.(*api.Service)
+	if web.Spec.ClusterIP != api.ClusterIPNone {
+		t.Errorf("got web app cluster IP %q, want a headless service", web.Spec.ClusterIP)
+	}
+
+	// A workload that is not headless gets no service.
+	for _, obj := range result.Objects {
+		if obj.Kind == "Service" && obj.Name == "cache" {
+			t.Errorf("got a service for cache, want none")
+		}
+	}
+
+	_, err := Convert(writeProject(t, `version: "2"
+services:
+  db:
+    image: postgres
+    labels:
+      kompose.service.headless: sometimes
+`, nil), Options{})
+	if err == nil {
+		t.Error("converting an invalid headless label succeeded, want an error")
+	}
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2015 Kelsey Hightower All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/fkautz/compose2kube/convert"
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/apis/apps"
+	"k8s.io/kubernetes/pkg/apis/batch"
+	"k8s.io/kubernetes/pkg/apis/extensions"
+	"k8s.io/kubernetes/pkg/runtime"
+)
+
+// kindPurposes describes what the objects of each kind do for a service, for
+// the service docs.
+var kindPurposes = map[string]string{
+	"ConfigMap":             "holds a file mounted into the container",
+	"Deployment":            "runs the pods of the service and rolls out updates to them",
+	"Endpoints":             "points the service at the addresses of the external dependency",
+	"Ingress":               "routes HTTP traffic from outside the cluster to the service",
+	"Job":                   "runs the pods of the service to completion",
+	"Pod":                   "runs the containers of the service once",
+	"ReplicationController": "keeps the replicas of the pods of the service running",
+	"Service":               "gives the pods a stable address in the cluster",
+	"StatefulSet":           "runs the pods of the service with stable names",
+}
+
+// newServiceDoc returns a markdown stub for the team running the named
+// service, describing its containers, the objects generated for it and how
+// to apply them from applyDir.
+func newServiceDoc(service string, objs []convert.Object, applyDir string) []byte {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "# %s\n\n", service)
+	fmt.Fprintf(&buf, "Generated by compose2kube from the `%s` compose service.\n", service)
+
+	var containers []api.Container
+	for _, obj := range objs {
+		if spec := podSpec(obj.Object); spec != nil {
+			containers = spec.Containers
+		}
+	}
+	if len(containers) > 0 {
+		buf.WriteString("\n## Containers\n\n")
+		buf.WriteString("| Container | Image | Ports |\n")
+		buf.WriteString("|-----------|-------|-------|\n")
+		for _, container := range containers {
+			var ports []string
+			for _, port := range container.Ports {
+				protocol := port.Protocol
+				if protocol == "" {
+					protocol = api.ProtocolTCP
+				}
+				ports = append(ports, fmt.Sprintf("%d/%s", port.ContainerPort, protocol))
+			}
+			if len(ports) == 0 {
+				ports = []string{"none"}
+			}
+			fmt.Fprintf(&buf, "| %s | `%s` | %s |\n", container.Name, container.Image, strings.Join(ports, ", "))
+		}
+	}
+
+	buf.WriteString("\n## Objects\n\n")
+	for _, obj := range objs {
+		fmt.Fprintf(&buf, "* %s `%s`", obj.Kind, obj.Name)
+		if purpose, ok := kindPurposes[obj.Kind]; ok {
+			fmt.Fprintf(&buf, ": %s", purpose)
+		}
+		buf.WriteString(".\n")
+	}
+
+	buf.WriteString("\n## Applying\n\n")
+	buf.WriteString("The objects are applied together with the rest of the project:\n\n")
+	fmt.Fprintf(&buf, "```\nkubectl apply -f %s\n```\n", applyDir)
+	return buf.Bytes()
+}
+
+// podSpec returns the pod spec of a workload, or nil for other objects.
+func podSpec(obj runtime.Object) *api.PodSpec {
+	switch o := obj.(type) {
+	case *api.ReplicationController:
+		return &o.Spec.Template.Spec
+	case *api.Pod:
+		return &o.Spec
+	case *extensions.Deployment:
+		return &o.Spec.Template.Spec
+	case *batch.Job:
+		return &o.Spec.Template.Spec
+	case *apps.StatefulSet:
+		return &o.Spec.Template.Spec
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+/*
+Copyright 2015 Kelsey Hightower All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/fkautz/compose2kube/convert"
+)
+
+func TestServiceDoc(t *testing.T) {
+	var objs []convert.Object
+	for _, obj := range convertCompose(t, twoServices).Objects {
+		if obj.Service == "web" {
+			objs = append(objs, obj)
+		}
+	}
+	doc := string(newServiceDoc("web", objs, "output"))
+
+	for _, want := range []string{
+		"# web\n\nGenerated by compose2kube from the `web` compose service.\n",
+		"| web | `nginx` | 80/TCP |\n",
+		"* Service `web`: gives the pods a stable address in the cluster.\n",
+		"* Deployment `web`: runs the pods of the service and rolls out updates to them.\n",
+		"```\nkubectl apply -f output\n```\n",
+	} {
+		if !strings.Contains(doc, want) {
+			t.Errorf("got doc:\n%s\nwant it to contain %q", doc, want)
+		}
+	}
+	if strings.Contains(doc, "database") {
+		t.Errorf("got doc:\n%s\nwant it to leave out the database service", doc)
+	}
+
+	// A service without a workload has no containers table.
+	doc = string(newServiceDoc("db", []convert.Object{{Kind: "Widget", Name: "db"}}, "output"))
+	if strings.Contains(doc, "## Containers") || !strings.Contains(doc, "* Widget `db`.\n") {
+		t.Errorf("got doc:\n%s\nwant an object without a purpose and no containers", doc)
+	}
+}
@@ -0,0 +1,95 @@
+/*
+Copyright 2015 Kelsey Hightower All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// fetchAttempts is the number of times a remote compose file is requested
+// before giving up.
+const fetchAttempts = 3
+
+// fetchBackoff is the delay before the first retry. It doubles with every
+// retry.
+const fetchBackoff = time.Second
+
+// isURL reports whether the compose file is to be fetched over HTTP.
+func isURL(path string) bool {
+	return strings.HasPrefix(path, "http://") || strings.HasPrefix(path, "https://")
+}
+
+// fetchCompose downloads the compose file at url to a temporary file in the
+// working directory, against which relative paths in the file are resolved,
+// and returns its path. Network errors and server errors are retried with
+// backoff, and each request is limited to timeout.
+func fetchCompose(url string, timeout time.Duration) (string, error) {
+	var data []byte
+	var err error
+	backoff := fetchBackoff
+	for attempt := 1; attempt <= fetchAttempts; attempt++ {
+		var retry bool
+		data, retry, err = fetch(url, timeout)
+		if err == nil || !retry || attempt == fetchAttempts {
+			break
+		}
+		time.Sleep(backoff)
+		backoff *= 2
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to fetch %s: %v", url, err)
+	}
+
+	f, err := ioutil.TempFile(".", ".compose2kube")
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	if _, err := f.Write(data); err != nil {
+		return "", err
+	}
+	return f.Name(), nil
+}
+
+// fetch requests url once. It reports whether a failed request is worth
+// retrying.
+func fetch(url string, timeout time.Duration) ([]byte, bool, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, false, err
+	}
+	resp, err := http.DefaultClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, true, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		retry := resp.StatusCode >= 500 || resp.StatusCode == http.StatusTooManyRequests
+		return nil, retry, fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, true, err
+	}
+	return data, false, nil
+}
@@ -0,0 +1,51 @@
+module github.com/fkautz/compose2kube
+
+go 1.22
+
+require (
+	github.com/docker/libcompose v0.4.0
+	github.com/ghodss/yaml v0.0.0-20150909031657-73d445a93680
+	github.com/pmezard/go-difflib v1.0.0
+	gopkg.in/yaml.v2 v2.0.0-20150924142314-53feefa2559f
+	k8s.io/kubernetes v1.5.8
+)
+
+require (
+	github.com/Microsoft/go-winio v0.6.2 // indirect
+	github.com/PuerkitoBio/purell v1.0.0 // indirect
+	github.com/PuerkitoBio/urlesc v0.0.0-20160726150825-5bd2802263f2 // indirect
+	github.com/Sirupsen/logrus v0.11.0 // indirect
+	github.com/davecgh/go-spew v1.1.0 // indirect
+	github.com/docker/distribution v2.6.0-rc.1.0.20161216195125-28602af35ace+incompatible // indirect
+	github.com/docker/docker v1.13.1 // indirect
+	github.com/docker/go-connections v0.2.2-0.20170204000113-ecb4cb2dd420 // indirect
+	github.com/docker/go-units v0.3.2-0.20161020213227-8a7beacffa30 // indirect
+	github.com/emicklei/go-restful v1.1.4-0.20160814184150-89ef8af493ab // indirect
+	github.com/exponent-io/jsonpath v0.0.0-20151013193312-d6023ce2651d // indirect
+	github.com/flynn/go-shlex v0.0.0-20150515145356-3f9db97f8568 // indirect
+	github.com/go-openapi/jsonpointer v0.0.0-20160704185906-46af16f9f7b1 // indirect
+	github.com/go-openapi/jsonreference v0.0.0-20160704190145-13c6e3589ad9 // indirect
+	github.com/go-openapi/spec v0.0.0-20160808142527-6aced65f8501 // indirect
+	github.com/go-openapi/swag v0.0.0-20160704191624-1d0bd113de87 // indirect
+	github.com/gogo/protobuf v0.0.0-20160718161353-e18d7aa8f8c6 // indirect
+	github.com/golang/glog v0.0.0-20141105023935-44145f04b68c // indirect
+	github.com/golang/protobuf v0.0.0-20160608215545-8616e8ee5e20 // indirect
+	github.com/google/gofuzz v0.0.0-20150304233714-bbcb9da2d746 // indirect
+	github.com/mailru/easyjson v0.0.0-20160728113105-d5b7844b561a // indirect
+	github.com/opencontainers/runc v0.1.1 // indirect
+	github.com/pborman/uuid v0.0.0-20150603214016-ca53cad383ca // indirect
+	github.com/pkg/errors v0.9.1 // indirect
+	github.com/spf13/pflag v0.0.0-20161024131444-5ccb023bc27d // indirect
+	github.com/stretchr/testify v1.1.4 // indirect
+	github.com/ugorji/go v0.0.0-20151028022000-f1f1a805ed36 // indirect
+	github.com/xeipuuv/gojsonpointer v0.0.0-20151027082146-e0fe6f683076 // indirect
+	github.com/xeipuuv/gojsonreference v0.0.0-20150808065054-e02fc20de94c // indirect
+	github.com/xeipuuv/gojsonschema v0.0.0-20160323030313-93e72a773fad // indirect
+	golang.org/x/net v0.0.0-20160715184138-e90d6d0afc4c // indirect
+	golang.org/x/sys v0.10.0 // indirect
+	golang.org/x/text v0.0.0-20160726164857-2910a502d2bf // indirect
+	gopkg.in/check.v1 v1.0.0-20201130134442-10cb98267c6c // indirect
+	gopkg.in/inf.v0 v0.9.0 // indirect
+)
+
+replace github.com/ugorji/go => ./third_party/forked/ugorji/go
@@ -17,172 +17,513 @@ import (
 	"encoding/json"
 	"flag"
 	"fmt"
-	"io/ioutil"
 	"log"
 	"os"
 	"path/filepath"
-	"strconv"
 	"strings"
+	"text/template"
+	"time"
 
-	"github.com/docker/libcompose/project"
+	"github.com/fkautz/compose2kube/convert"
 	"k8s.io/kubernetes/pkg/api"
-	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/api/resource"
 )
 
 var (
-	composeFile string
-	outputDir   string
+	composeFile     string
+	projects        projectList
+	outputDir       string
+	allowUnset      bool
+	skaffold        bool
+	dirTemplate     string
+	controller      string
+	injectTini      bool
+	reportFile      string
+	wrapCRD         string
+	emitLinkEnv     bool
+	envLastWins     bool
+	kindOrders      string
+	stripLabels     bool
+	annotations     string
+	bundle          bool
+	fetchTimeout    time.Duration
+	formats         string
+	only            string
+	skip            string
+	profiles        string
+	namePrefix      string
+	defaultDeny     bool
+	waitForDeps     bool
+	inferCtrl       bool
+	defaultRestart  string
+	envFromFiles    string
+	configMapFiles  bool
+	list            bool
+	autoProbe       bool
+	strict          bool
+	fragment        string
+	colocate        bool
+	apiVersions     string
+	applyScript     bool
+	emitDocs        bool
+	validateImages  bool
+	registryTimeout time.Duration
+	insecureRegs    string
+	diff            bool
+	outputArchive   string
+	history         int
+	quiet           bool
+	dirModes        string
+	fileModes       string
+
+	defaultCPURequest    string
+	defaultMemoryRequest string
+	defaultCPULimit      string
+	defaultMemoryLimit   string
+	limitRange           bool
+	maxCPULimit          string
+	maxMemoryLimit       string
+	quotaCPU             string
+	quotaMemory          string
+	quotaPods            string
+
+	outputFormats []string
+
+	// Permissions of the output directories and files.
+	dirMode  os.FileMode
+	fileMode os.FileMode
+
+	// differences is set when -diff finds changes to the output.
+	differences bool
+
+	// archive receives the output files with -output-archive.
+	archive *archiveWriter
 )
 
 func init() {
-	flag.StringVar(&composeFile, "compose-file", "docker-compose.yml", "Specify an alternate compose `file`")
-	flag.StringVar(&outputDir, "output-dir", "output", "Kubernetes configs output `directory`")
+	flag.StringVar(&composeFile, "compose-file", "docker-compose.yml", "Specify an alternate compose `file`, directory or http(s) URL")
+	flag.Var(&projects, "project", "Convert the independent compose project at `name=path` into the name subdirectory of the output directory, prefixing its objects with name-; can be repeated")
+	flag.DurationVar(&fetchTimeout, "fetch-timeout", 30*time.Second, "Timeout of each request when the compose file is an http(s) URL")
+	flag.StringVar(&only, "only", "", "Comma-separated `services` to convert, skipping all others")
+	flag.StringVar(&skip, "skip", "", "Comma-separated `services` to leave out of the conversion")
+	flag.StringVar(&profiles, "profile", os.Getenv("COMPOSE_PROFILES"), "Comma-separated `profiles` to enable, defaulting to $COMPOSE_PROFILES")
+	flag.StringVar(&namePrefix, "name-prefix", "", "`Prefix` added to the names of the generated objects, e.g. myproject-")
+	flag.StringVar(&outputDir, "output-dir", "output", "Kubernetes configs output `directory`, or - to print the objects of a single service")
+	flag.BoolVar(&allowUnset, "allow-unset", false, "Warn instead of failing when a variable without a default is not set")
+	flag.StringVar(&dirModes, "dir-mode", "0755", "Octal permission `mode` of the created output directories")
+	flag.StringVar(&fileModes, "file-mode", "0644", "Octal permission `mode` of the written files; apply.sh is also executable where readable")
+	flag.StringVar(&formats, "output-format", formatYAML, "Comma-separated `formats` to write each object in: json, yaml or both")
+	flag.StringVar(&dirTemplate, "dir-template", "", "Go `template` for a per-service output directory, e.g. output/{{.Service}}")
+	flag.StringVar(&controller, "controller", convert.ControllerRC, "Kind of object to create for each service: rc, pod, deployment, job or statefulset")
+	flag.StringVar(&envFromFiles, "env-from-files", "", "Read environment values of the form ${file:path} from the file, and inline them or move them to a secret of the service: inline or secret")
+	flag.StringVar(&defaultRestart, "default-restart", "always", "Restart policy of the services without one: always, no or on-failure")
+	flag.BoolVar(&inferCtrl, "infer-controller", false, "Pick the controller of each service from its restart policy instead of -controller: a deployment, a pod for no or a job for on-failure")
+	flag.BoolVar(&injectTini, "inject-tini", false, "Run the command of services with init: true under tini, which must be present in the image")
+	flag.IntVar(&history, "revision-history", -1, "Number of old replica sets each deployment keeps for rollbacks; the Kubernetes default when negative")
+	flag.StringVar(&fragment, "fragment", "", "Output only part of each controller; podtemplate outputs the pod template spec")
+	flag.StringVar(&wrapCRD, "wrap-crd", "", "Experimental: wrap each workload in a custom resource of the given Group/Version/`Kind`")
+	flag.BoolVar(&emitLinkEnv, "emit-link-env", false, "Add the legacy Docker link environment variables for each dependency")
+	flag.BoolVar(&envLastWins, "env-last-wins", true, "Let later env_file entries and environment override earlier values; false keeps the first value")
+	flag.StringVar(&apiVersions, "api-versions", "", "Override the apiVersion of object kinds, e.g. Deployment=apps/v1,Ingress=networking.k8s.io/v1")
+	flag.StringVar(&kindOrders, "kind-order", "", "Override the apply order file prefix of object kinds, e.g. Secret=05,Service=25")
+	flag.BoolVar(&stripLabels, "strip-labels", false, "Omit the tool-managed labels from the metadata of controllers")
+	flag.StringVar(&annotations, "annotation", "", "Add annotations to every generated object, e.g. team=web,tier=frontend")
+	flag.BoolVar(&list, "list", false, "Write all objects to a single List in the output directory, ordered by apply order")
+	flag.BoolVar(&bundle, "per-service-bundle", false, "Write the objects of each service to a single multi-document <service>.yaml")
+	flag.StringVar(&defaultCPURequest, "default-cpu-request", "", "CPU `quantity` requested by services that set no CPU resources")
+	flag.StringVar(&defaultMemoryRequest, "default-memory-request", "", "Memory `quantity` requested by services that set no memory resources")
+	flag.StringVar(&defaultCPULimit, "default-cpu-limit", "", "CPU limit `quantity` of services that set no CPU resources")
+	flag.StringVar(&defaultMemoryLimit, "default-memory-limit", "", "Memory limit `quantity` of services that set no memory resources")
+	flag.BoolVar(&limitRange, "limit-range", false, "Add a LimitRange to the namespace with the default resources as defaults and the maximum resources as bounds")
+	flag.StringVar(&maxCPULimit, "max-cpu-limit", "", "Maximum CPU `quantity` of a container in the -limit-range")
+	flag.StringVar(&maxMemoryLimit, "max-memory-limit", "", "Maximum memory `quantity` of a container in the -limit-range")
+	flag.StringVar(&quotaCPU, "quota-cpu", "", "Total CPU `quantity` the pods of the namespace may request, in a ResourceQuota")
+	flag.StringVar(&quotaMemory, "quota-memory", "", "Total memory `quantity` the pods of the namespace may request, in a ResourceQuota")
+	flag.StringVar(&quotaPods, "quota-pods", "", "Maximum `number` of pods in the namespace, in a ResourceQuota")
+	flag.BoolVar(&configMapFiles, "configmap-files", false, "Replace read-only bind mounts of single files with a ConfigMap holding the file")
+	flag.BoolVar(&autoProbe, "auto-probe", false, "Add a TCP readiness probe to services that expose a single port")
+	flag.BoolVar(&strict, "strict", false, "Fail instead of warning when an option cannot be translated faithfully")
+	flag.BoolVar(&defaultDeny, "default-deny", false, "Add network policies denying ingress traffic in the namespace except between services sharing a compose network")
+	flag.BoolVar(&waitForDeps, "wait-for-dependencies", false, "Add init containers waiting for the depends_on services of each service to start, or to be healthy")
+	flag.BoolVar(&colocate, "colocate-dependencies", false, "Prefer scheduling services on the nodes running their depends_on services")
+	flag.BoolVar(&quiet, "quiet", false, "Do not print the summary of the generated objects to stderr")
+	flag.StringVar(&reportFile, "report", "", "Write a JSON summary of the conversion to `file`")
+	flag.BoolVar(&applyScript, "emit-apply-script", false, "Write an apply.sh to the output directory that applies the manifests in order")
+	flag.StringVar(&outputArchive, "output-archive", "", "Write the output files to a tar archive at `file` instead of the output directory, gzipped if it ends in .gz or .tgz")
+	flag.BoolVar(&diff, "diff", false, "Print the changes to the files in the output directory instead of writing them, and exit with status 1 if there are any")
+	flag.BoolVar(&emitDocs, "emit-docs", false, "Write a markdown <service>.md describing the objects generated for each service")
+	flag.BoolVar(&validateImages, "validate-images", false, "Check that the image of each service exists in its registry, using the credentials of the Docker config")
+	flag.DurationVar(&registryTimeout, "registry-timeout", 10*time.Second, "Timeout of each image check of -validate-images")
+	flag.StringVar(&insecureRegs, "insecure-registries", "", "Comma-separated `registries` that -validate-images reaches over plain HTTP instead of HTTPS")
+	flag.BoolVar(&skaffold, "skaffold", false, "Write a skaffold.yaml for the generated manifests to the output directory")
+}
+
+// parseAnnotations parses a comma-separated list of key=value pairs.
+func parseAnnotations(s string) (map[string]string, error) {
+	annotations := make(map[string]string)
+	for _, entry := range strings.Split(s, ",") {
+		parts := strings.SplitN(strings.TrimSpace(entry), "=", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return nil, fmt.Errorf("invalid entry %q, must be key=value", entry)
+		}
+		annotations[parts[0]] = parts[1]
+	}
+	return annotations, nil
+}
+
+// parseResources sets the default and maximum resources, the limit range
+// and the resource quota of opts from the resource flags.
+func parseResources(opts *convert.Options) error {
+	quantities := []struct {
+		flag  string
+		value string
+		list  *api.ResourceList
+		name  api.ResourceName
+	}{
+		{"default-cpu-request", defaultCPURequest, &opts.DefaultResources.Requests, api.ResourceCPU},
+		{"default-memory-request", defaultMemoryRequest, &opts.DefaultResources.Requests, api.ResourceMemory},
+		{"default-cpu-limit", defaultCPULimit, &opts.DefaultResources.Limits, api.ResourceCPU},
+		{"default-memory-limit", defaultMemoryLimit, &opts.DefaultResources.Limits, api.ResourceMemory},
+		{"max-cpu-limit", maxCPULimit, &opts.MaxResources, api.ResourceCPU},
+		{"max-memory-limit", maxMemoryLimit, &opts.MaxResources, api.ResourceMemory},
+		{"quota-cpu", quotaCPU, &opts.Quota, api.ResourceCPU},
+		{"quota-memory", quotaMemory, &opts.Quota, api.ResourceMemory},
+		{"quota-pods", quotaPods, &opts.Quota, api.ResourcePods},
+	}
+	for _, q := range quantities {
+		if q.value == "" {
+			continue
+		}
+		quantity, err := resource.ParseQuantity(q.value)
+		if err != nil {
+			return fmt.Errorf("invalid -%s %s: %v", q.flag, q.value, err)
+		}
+		if *q.list == nil {
+			*q.list = api.ResourceList{}
+		}
+		(*q.list)[q.name] = quantity
+	}
+
+	if len(opts.MaxResources) > 0 && !limitRange {
+		return fmt.Errorf("the -max-cpu-limit and -max-memory-limit flags require -limit-range")
+	}
+	opts.LimitRange = limitRange
+	return nil
+}
+
+// writeObjects saves each object to the configs directory and returns the
+// paths of the manifests. The objects of a service go to the directory
+// rendered from -dir-template, bundled into a single file with
+// -per-service-bundle.
+func writeObjects(objs []convert.Object, dirTmpl *template.Template) []string {
+	var manifests []string
+	var services []string
+	bundles := make(map[string][]convert.Object)
+	for _, obj := range objs {
+		if bundle && obj.Service != "" {
+			if _, ok := bundles[obj.Service]; !ok {
+				services = append(services, obj.Service)
+			}
+			bundles[obj.Service] = append(bundles[obj.Service], obj)
+			continue
+		}
+		dir, err := objectDir(dirTmpl, obj.Service)
+		if err != nil {
+			log.Fatalf("Failed to create the output directory for %s: %v", obj.BaseName, err)
+		}
+		outputFilePaths, err := writeObject(dir, obj.Kind, obj.BaseName, obj.Object)
+		if err != nil {
+			log.Fatalf("Failed to write %s: %v", obj.BaseName, err)
+		}
+		manifests = append(manifests, manifestPath(outputFilePaths[0]))
+	}
+	for _, service := range services {
+		dir, err := objectDir(dirTmpl, service)
+		if err != nil {
+			log.Fatalf("Failed to create the output directory for service %s: %v", service, err)
+		}
+		outputFilePath, err := writeBundle(dir, service+".yaml", bundles[service])
+		if err != nil {
+			log.Fatalf("Failed to write the bundle for service %s: %v", service, err)
+		}
+		manifests = append(manifests, manifestPath(outputFilePath))
+	}
+	return manifests
 }
 
 func main() {
 	flag.Parse()
 
-	p := project.NewProject(&project.Context{
-		ProjectName:  "kube",
-		ComposeFiles: []string{composeFile},
-	}, nil, nil)
-
-	if err := p.Parse(); err != nil {
-		log.Fatalf("Failed to parse the compose project from %s: %v", composeFile, err)
-	}
-	if err := os.MkdirAll(outputDir, 0755); err != nil {
-		log.Fatalf("Failed to create the output directory %s: %v", outputDir, err)
-	}
-
-	if p.ServiceConfigs == nil {
-		log.Fatalf("No service config found, aborting")
-	}
-	keys := p.ServiceConfigs.Keys()
-
-	for _, name := range keys {
-		service, ok := p.ServiceConfigs.Get(name)
-		if !ok {
-			log.Fatalf("Failed to get key %s from config", name)
-		}
-
-		rc := &api.ReplicationController{
-			TypeMeta: unversioned.TypeMeta{
-				Kind:       "ReplicationController",
-				APIVersion: "v1",
-			},
-			ObjectMeta: api.ObjectMeta{
-				Name:   name,
-				Labels: map[string]string{"service": name},
-			},
-			Spec: api.ReplicationControllerSpec{
-				Replicas: 1,
-				Selector: map[string]string{"service": name},
-				Template: &api.PodTemplateSpec{
-					ObjectMeta: api.ObjectMeta{
-						Labels: map[string]string{"service": name},
-					},
-					Spec: api.PodSpec{
-						Containers: []api.Container{
-							{
-								Name:    name,
-								Image:   service.Image,
-								Command: service.Command,
-							},
-						},
-					},
-				},
-			},
-		}
-
-		// Configure the container ports.
-		var ports []api.ContainerPort
-		for _, port := range service.Ports {
-			// Check if we have to deal with a mapped port
-			port = strings.Trim(port, "\"")
-			port = strings.TrimSpace(port)
-			if strings.Contains(port, ":") {
-				parts := strings.Split(port, ":")
-				port = parts[1]
-			}
-			portNumber, err := strconv.ParseInt(port, 10, 32)
-			if err != nil {
-				log.Fatalf("Invalid container port %s for service %s", port, name)
-			}
-			ports = append(ports, api.ContainerPort{ContainerPort: int32(portNumber)})
-		}
-		rc.Spec.Template.Spec.Containers[0].Ports = ports
-
-		// Configure the container ENV variables
-		var envs []api.EnvVar
-		for _, env := range service.Environment {
-			if strings.Contains(env, "=") {
-				parts := strings.Split(env, "=")
-				ename := parts[0]
-				evalue := parts[1]
-				envs = append(envs, api.EnvVar{Name: ename, Value: evalue})
-			}
+	opts := convert.Options{
+		Controller:           controller,
+		AllowUnset:           allowUnset,
+		InjectTini:           injectTini,
+		EmitLinkEnv:          emitLinkEnv,
+		EnvFirstWins:         !envLastWins,
+		StripLabels:          stripLabels,
+		ConfigMapFiles:       configMapFiles,
+		AutoProbe:            autoProbe,
+		Strict:               strict,
+		Fragment:             fragment,
+		ColocateDependencies: colocate,
+		NamePrefix:           namePrefix,
+		DefaultDeny:          defaultDeny,
+		WaitForDependencies:  waitForDeps,
+		InferController:      inferCtrl,
+		DefaultRestart:       defaultRestart,
+		EnvFromFiles:         envFromFiles,
+	}
+
+	if only != "" && skip != "" {
+		log.Fatalf("The -only and -skip flags cannot be used together")
+	}
+	if history >= 0 {
+		limit := int32(history)
+		opts.RevisionHistoryLimit = &limit
+	}
+	if profiles != "" {
+		opts.Profiles = strings.Split(profiles, ",")
+	}
+	if only != "" {
+		opts.Only = strings.Split(only, ",")
+	}
+	if skip != "" {
+		opts.Skip = strings.Split(skip, ",")
+	}
+
+	var err error
+	outputFormats, err = parseOutputFormats(formats)
+	if err != nil {
+		log.Fatalf("Invalid output format: %v", err)
+	}
+
+	if dirMode, err = parseMode(dirModes); err != nil {
+		log.Fatalf("Invalid -dir-mode: %v", err)
+	}
+	if fileMode, err = parseMode(fileModes); err != nil {
+		log.Fatalf("Invalid -file-mode: %v", err)
+	}
+
+	if err := parseResources(&opts); err != nil {
+		log.Fatalf("Invalid resources: %v", err)
+	}
+
+	if kindOrders != "" {
+		if err := parseKindOrder(kindOrders); err != nil {
+			log.Fatalf("Invalid kind order: %v", err)
 		}
-		rc.Spec.Template.Spec.Containers[0].Env = envs
-
-		// Configure the volumes
-		var volumemounts []api.VolumeMount
-		var volumes []api.Volume
-		for _, volumestr := range service.Volumes {
-			parts := strings.Split(volumestr, ":")
-			partHostDir := parts[0]
-			partContainerDir := parts[1]
-			partReadOnly := false
-			if len(parts) > 2 {
-				for _, partOpt := range parts[2:] {
-					switch partOpt {
-					case "ro":
-						partReadOnly = true
-						break
-					case "rw":
-						partReadOnly = false
-						break
-					}
-				}
-			}
-			partName := strings.Replace(partHostDir, "/", "", -1)
-			if len(parts) > 2 {
-				volumemounts = append(volumemounts, api.VolumeMount{Name: partName, ReadOnly: partReadOnly, MountPath: partContainerDir})
-			} else {
-				volumemounts = append(volumemounts, api.VolumeMount{Name: partName, ReadOnly: partReadOnly, MountPath: partContainerDir})
-			}
-			source := &api.HostPathVolumeSource{
-				Path: partHostDir,
+	}
+
+	if wrapCRD != "" {
+		gvk, err := convert.ParseGroupVersionKind(wrapCRD)
+		if err != nil {
+			log.Fatalf("Invalid custom resource kind: %v", err)
+		}
+		opts.WrapCRD = &gvk
+	}
+
+	if apiVersions != "" {
+		versions, err := parseAnnotations(apiVersions)
+		if err != nil {
+			log.Fatalf("Invalid API versions: %v", err)
+		}
+		opts.APIVersions = versions
+	}
+
+	if annotations != "" {
+		a, err := parseAnnotations(annotations)
+		if err != nil {
+			log.Fatalf("Invalid annotations: %v", err)
+		}
+		opts.Mutators = append(opts.Mutators, convert.AnnotationMutator{Annotations: a})
+	}
+
+	if list && bundle {
+		log.Fatalf("The -list and -per-service-bundle flags cannot be used together")
+	}
+	if diff && outputArchive != "" {
+		log.Fatalf("The -diff and -output-archive flags cannot be used together")
+	}
+	if outputDir == stdoutDir && (list || bundle || dirTemplate != "" || diff || outputArchive != "" || skaffold || applyScript || emitDocs) {
+		log.Fatalf("The -output-dir - flag cannot be combined with flags writing files to the output directory")
+	}
+
+	if len(projects) > 0 {
+		flag.Visit(func(f *flag.Flag) {
+			if f.Name == "compose-file" {
+				log.Fatalf("The -project and -compose-file flags cannot be used together")
 			}
-			vsource := api.VolumeSource{HostPath: source}
-			volumes = append(volumes, api.Volume{Name: partName, VolumeSource: vsource})
+		})
+		if outputDir == stdoutDir || outputArchive != "" || dirTemplate != "" || reportFile != "" {
+			log.Fatalf("The -project flag cannot be combined with -output-dir -, -output-archive, -dir-template or -report")
+		}
+	}
+
+	var dirTmpl *template.Template
+	if dirTemplate != "" {
+		if dirTmpl, err = parseDirTemplate(dirTemplate); err != nil {
+			log.Fatalf("Invalid output directory template %s: %v", dirTemplate, err)
+		}
+	}
+
+	if len(projects) == 0 {
+		convertProject(composeFile, opts, dirTmpl)
+	}
+	convertProjects(projects, opts, dirTmpl)
+
+	if diff {
+		if err := diffRemovals(outputDir); err != nil {
+			log.Fatalf("Failed to compare the output directory: %v", err)
+		}
+	}
+
+	if archive != nil {
+		if err := archive.Close(); err != nil {
+			log.Fatalf("Failed to write the output archive %s: %v", outputArchive, err)
+		}
+	}
+
+	if differences {
+		os.Exit(1)
+	}
+}
+
+// convertProjects converts each of the projects into its own subdirectory of
+// the output directory. Each project gets its own name prefix, so that their
+// objects can share a namespace without clashing.
+func convertProjects(projects projectList, opts convert.Options, dirTmpl *template.Template) {
+	baseOutputDir := outputDir
+	for _, p := range projects {
+		projectOpts := opts
+		projectOpts.NamePrefix = opts.NamePrefix + p.Name + "-"
+		outputDir = filepath.Join(baseOutputDir, p.Name)
+		convertProject(p.ComposeFile, projectOpts, dirTmpl)
+	}
+	outputDir = baseOutputDir
+}
+
+// convertProject converts the compose file at composeFile, a path or an
+// http(s) URL, and writes the objects to the output directory.
+func convertProject(composeFile string, opts convert.Options, dirTmpl *template.Template) {
+	var err error
+	path := composeFile
+	if isURL(composeFile) {
+		path, err = fetchCompose(composeFile, fetchTimeout)
+		if err != nil {
+			log.Fatalf("Failed to load the compose file: %v", err)
+		}
+	}
+	result, err := convert.Convert(path, opts)
+	if path != composeFile {
+		os.Remove(path)
+	}
+	if err != nil {
+		log.Fatalf("Failed to convert %s: %v", composeFile, err)
+	}
+
+	if validateImages {
+		var insecure []string
+		if insecureRegs != "" {
+			insecure = strings.Split(insecureRegs, ",")
+		}
+		checkImages(result, registryTimeout, insecure)
+	}
+
+	switch {
+	case outputDir == stdoutDir, diff:
+		// Nothing is written to the output directory.
+	case outputArchive != "":
+		archive, err = createArchive(outputArchive)
+		if err != nil {
+			log.Fatalf("Failed to create the output archive %s: %v", outputArchive, err)
+		}
+	default:
+		if err := mkdirAll(outputDir); err != nil {
+			log.Fatalf("Failed to create the output directory: %v", err)
+		}
+	}
+
+	// Save the objects to the configs directory, either all together in a
+	// single list or each to its own file, or print them.
+	var manifests []string
+	switch {
+	case outputDir == stdoutDir:
+		if err := writeStdout(os.Stdout, result.Objects); err != nil {
+			log.Fatalf("Failed to print the objects: %v", err)
+		}
+	case list:
+		outputFilePaths, err := writeList(outputDir, result.Objects)
+		if err != nil {
+			log.Fatalf("Failed to write the object list: %v", err)
 		}
-		rc.Spec.Template.Spec.Containers[0].VolumeMounts = volumemounts
-		rc.Spec.Template.Spec.Volumes = volumes
+		manifests = append(manifests, manifestPath(outputFilePaths[0]))
+	default:
+		manifests = writeObjects(result.Objects, dirTmpl)
+	}
 
-		// Configure the container restart policy.
-		switch service.Restart {
-		case "", "always":
-			rc.Spec.Template.Spec.RestartPolicy = api.RestartPolicyAlways
-		case "no":
-			rc.Spec.Template.Spec.RestartPolicy = api.RestartPolicyNever
-		case "on-failure":
-			rc.Spec.Template.Spec.RestartPolicy = api.RestartPolicyOnFailure
-		default:
-			log.Fatalf("Unknown restart policy %s for service %s", service.Restart, name)
+	if reportFile != "" {
+		data, err := json.MarshalIndent(result.Report, "", "  ")
+		if err != nil {
+			log.Fatalf("Failed to marshal the conversion report: %v", err)
 		}
+		if err := writeFile(reportFile, data, fileMode); err != nil {
+			log.Fatalf("Failed to write the conversion report %s: %v", reportFile, err)
+		}
+	}
 
-		data, err := json.MarshalIndent(rc, "", "  ")
+	if skaffold {
+		var artifacts []skaffoldArtifact
+		for _, build := range result.Builds {
+			artifact := skaffoldArtifact{Image: build.Image, Context: build.Context}
+			if build.Dockerfile != "" {
+				artifact.Docker = &skaffoldDockerConfig{Dockerfile: build.Dockerfile}
+			}
+			artifacts = append(artifacts, artifact)
+		}
+		data, err := newSkaffoldConfig(artifacts, manifests)
 		if err != nil {
-			log.Fatalf("Failed to marshal the replication controller: %v", err)
+			log.Fatalf("Failed to marshal the skaffold config: %v", err)
+		}
+		outputFilePath := filepath.Join(outputDir, "skaffold.yaml")
+		if err := saveFile(outputFilePath, data, fileMode); err != nil {
+			log.Fatalf("Failed to write skaffold config: %v", err)
+		}
+	}
+
+	if applyScript {
+		outputFilePath := filepath.Join(outputDir, "apply.sh")
+		if err := saveFile(outputFilePath, newApplyScript(manifests), executableMode(fileMode)); err != nil {
+			log.Fatalf("Failed to write the apply script: %v", err)
+		}
+	}
+
+	if emitDocs {
+		var services []string
+		serviceObjects := make(map[string][]convert.Object)
+		for _, obj := range result.Objects {
+			if obj.Service == "" {
+				continue
+			}
+			if _, ok := serviceObjects[obj.Service]; !ok {
+				services = append(services, obj.Service)
+			}
+			serviceObjects[obj.Service] = append(serviceObjects[obj.Service], obj)
+		}
+		for _, service := range services {
+			dir, err := objectDir(dirTmpl, service)
+			if err != nil {
+				log.Fatalf("Failed to create the output directory for service %s: %v", service, err)
+			}
+			outputFilePath := filepath.Join(dir, service+".md")
+			if err := saveFile(outputFilePath, newServiceDoc(service, serviceObjects[service], outputDir), fileMode); err != nil {
+				log.Fatalf("Failed to write the docs for service %s: %v", service, err)
+			}
 		}
+	}
 
-		// Save the replication controller for the Docker compose service to the
-		// configs directory.
-		outputFileName := fmt.Sprintf("%s-rc.yaml", name)
-		outputFilePath := filepath.Join(outputDir, outputFileName)
-		if err := ioutil.WriteFile(outputFilePath, data, 0644); err != nil {
-			log.Fatalf("Failed to write replication controller %s: %v", outputFileName, err)
+	if !quiet {
+		if err := writeSummary(os.Stderr, result.Objects); err != nil {
+			log.Fatalf("Failed to print the summary: %v", err)
 		}
-		fmt.Println(outputFilePath)
 	}
 }
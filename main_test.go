@@ -0,0 +1,331 @@
+/*
+Copyright 2015 Kelsey Hightower All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/fkautz/compose2kube/convert"
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/resource"
+)
+
+// withOutput points the output directory at a temporary directory, written
+// to in the given formats, and restores the output settings when the test
+// ends. It returns the output directory.
+func withOutput(t *testing.T, formats ...string) string {
+	t.Helper()
+	savedDir, savedFormats := outputDir, outputFormats
+	savedDirMode, savedFileMode := dirMode, fileMode
+	t.Cleanup(func() {
+		outputDir, outputFormats = savedDir, savedFormats
+		dirMode, fileMode = savedDirMode, savedFileMode
+	})
+	outputDir = t.TempDir()
+	outputFormats = formats
+	dirMode, fileMode = 0755, 0644
+	return outputDir
+}
+
+// writeCompose writes compose to a docker-compose.yml in a temporary
+// directory and returns its path.
+func writeCompose(t *testing.T, compose string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "docker-compose.yml")
+	if err := ioutil.WriteFile(path, []byte(compose), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+// convertCompose converts compose with the default options.
+func convertCompose(t *testing.T, compose string) *convert.Result {
+	t.Helper()
+	result, err := convert.Convert(writeCompose(t, compose), convert.Options{})
+	if err != nil {
+		t.Fatalf("Convert: %v", err)
+	}
+	return result
+}
+
+// captureStdout returns what f prints to the standard output.
+func captureStdout(t *testing.T, f func()) string {
+	t.Helper()
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	stdout := os.Stdout
+	os.Stdout = w
+	done := make(chan []byte)
+	go func() {
+		data, _ := ioutil.ReadAll(r)
+		done <- data
+	}()
+	func() {
+		defer func() {
+			os.Stdout = stdout
+			w.Close()
+		}()
+		f()
+	}()
+	return string(<-done)
+}
+
+// listFiles returns the paths of the files below dir, relative to it, in
+// order.
+func listFiles(t *testing.T, dir string) []string {
+	t.Helper()
+	var files []string
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		rel, err := filepath.Rel(dir, path)
+		files = append(files, rel)
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sort.Strings(files)
+	return files
+}
+
+const twoServices = `version: "2"
+services:
+  web:
+    image: nginx
+    ports:
+      - "80"
+    labels:
+      kompose.service.kind: webapp
+  database:
+    image: postgres
+`
+
+func TestDirTemplate(t *testing.T) {
+	dir := withOutput(t, formatYAML)
+	tmpl, err := parseDirTemplate(filepath.Join(dir, "{{.Service}}"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	writeObjects(convertCompose(t, twoServices).Objects, tmpl)
+
+	want := []string{
+		"database/30-database-rc.yaml",
+		"web/20-web-svc.yaml",
+		"web/30-web-deployment.yaml",
+	}
+	if got := listFiles(t, dir); !reflect.DeepEqual(got, want) {
+		t.Errorf("got files %q, want %q", got, want)
+	}
+}
+
+func TestDirTemplateInvalid(t *testing.T) {
+	for _, s := range []string{
+		"output/{{.Service",
+		"output/{{.Name}}",
+	} {
+		if _, err := parseDirTemplate(s); err == nil {
+			t.Errorf("parseDirTemplate(%q) succeeded, want an error", s)
+		}
+	}
+}
+
+// withResourceFlags clears the resource flags and restores them when the test
+// ends.
+func withResourceFlags(t *testing.T) {
+	t.Helper()
+	flags := []*string{
+		&defaultCPURequest, &defaultMemoryRequest, &defaultCPULimit, &defaultMemoryLimit,
+		&maxCPULimit, &maxMemoryLimit, &quotaCPU, &quotaMemory, &quotaPods,
+	}
+	saved := make([]string, len(flags))
+	for i, flag := range flags {
+		saved[i], *flag = *flag, ""
+	}
+	savedLimitRange := limitRange
+	t.Cleanup(func() {
+		for i, flag := range flags {
+			*flag = saved[i]
+		}
+		limitRange = savedLimitRange
+	})
+	limitRange = false
+}
+
+// sameQuantities reports whether list holds exactly the quantities in want,
+// given as strings.
+func sameQuantities(list api.ResourceList, want map[api.ResourceName]string) bool {
+	if len(list) != len(want) {
+		return false
+	}
+	for name, value := range want {
+		q, ok := list[name]
+		if !ok || q.Cmp(resource.MustParse(value)) != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func TestLimitRange(t *testing.T) {
+	withResourceFlags(t)
+	limitRange = true
+	defaultCPURequest, defaultMemoryLimit = "100m", "256Mi"
+	maxCPULimit, maxMemoryLimit = "2", "1Gi"
+
+	var opts convert.Options
+	if err := parseResources(&opts); err != nil {
+		t.Fatal(err)
+	}
+	result, err := convert.Convert(writeCompose(t, twoServices), opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var limits *api.LimitRange
+	for _, obj := range result.Objects {
+		if obj.Kind == "LimitRange" {
+			limits = obj.Object.(*api.LimitRange)
+		}
+	}
+	if limits == nil {
+		t.Fatal("got no limit range")
+	}
+	if len(limits.Spec.Limits) != 1 || limits.Spec.Limits[0].Type != api.LimitTypeContainer {
+		t.Fatalf("got limits %v, want a single container limit", limits.Spec.Limits)
+	}
+	item := limits.Spec.Limits[0]
+	if !sameQuantities(item.DefaultRequest, map[api.ResourceName]string{api.ResourceCPU: "100m"}) {
+		t.Errorf("got default requests %v, want cpu 100m", item.DefaultRequest)
+	}
+	if !sameQuantities(item.Default, map[api.ResourceName]string{api.ResourceMemory: "256Mi"}) {
+		t.Errorf("got default limits %v, want memory 256Mi", item.Default)
+	}
+	if !sameQuantities(item.Max, map[api.ResourceName]string{api.ResourceCPU: "2", api.ResourceMemory: "1Gi"}) {
+		t.Errorf("got maximum %v, want cpu 2 and memory 1Gi", item.Max)
+	}
+}
+
+func TestLimitRangeInvalid(t *testing.T) {
+	tests := []struct {
+		flag       *string
+		value      string
+		limitRange bool
+	}{
+		{&defaultCPURequest, "lots", true},
+		{&defaultMemoryLimit, "1.5.0Gi", true},
+		{&maxCPULimit, "2 cores", true},
+		// A maximum needs a limit range to go into.
+		{&maxMemoryLimit, "1Gi", false},
+	}
+	for _, test := range tests {
+		withResourceFlags(t)
+		*test.flag, limitRange = test.value, test.limitRange
+		var opts convert.Options
+		if err := parseResources(&opts); err == nil {
+			t.Errorf("parsing %s with -limit-range=%t succeeded, want an error", test.value, test.limitRange)
+		}
+	}
+
+	// The defaults must be within the maximum.
+	withResourceFlags(t)
+	limitRange = true
+	defaultMemoryLimit, maxMemoryLimit = "2Gi", "1Gi"
+	var opts convert.Options
+	if err := parseResources(&opts); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := convert.Convert(writeCompose(t, twoServices), opts); err == nil {
+		t.Error("converting a default limit above the maximum succeeded, want an error")
+	}
+}
+
+func TestResourceQuota(t *testing.T) {
+	withResourceFlags(t)
+	quotaCPU, quotaMemory, quotaPods = "4", "8Gi", "20"
+
+	var opts convert.Options
+	if err := parseResources(&opts); err != nil {
+		t.Fatal(err)
+	}
+	result, err := convert.Convert(writeCompose(t, twoServices), opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var quota *api.ResourceQuota
+	for _, obj := range result.Objects {
+		if obj.Kind == "ResourceQuota" {
+			quota = obj.Object.(*api.ResourceQuota)
+		}
+	}
+	if quota == nil {
+		t.Fatal("got no resource quota")
+	}
+	want := map[api.ResourceName]string{api.ResourceCPU: "4", api.ResourceMemory: "8Gi", api.ResourcePods: "20"}
+	if !sameQuantities(quota.Spec.Hard, want) {
+		t.Errorf("got hard limits %v, want %v", quota.Spec.Hard, want)
+	}
+}
+
+func TestResourceQuotaInvalid(t *testing.T) {
+	for _, test := range []struct {
+		flag  *string
+		value string
+	}{
+		{&quotaCPU, "four"},
+		{&quotaMemory, "8 GiB"},
+		{&quotaPods, "many"},
+	} {
+		withResourceFlags(t)
+		*test.flag = test.value
+		var opts convert.Options
+		if err := parseResources(&opts); err == nil {
+			t.Errorf("parsing quota %s succeeded, want an error", test.value)
+		}
+	}
+
+	// The number of pods must be whole.
+	withResourceFlags(t)
+	quotaPods = "2.5"
+	var opts convert.Options
+	if err := parseResources(&opts); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := convert.Convert(writeCompose(t, twoServices), opts); err == nil {
+		t.Error("converting a quota of 2.5 pods succeeded, want an error")
+	}
+}
+
+func TestParseAPIVersions(t *testing.T) {
+	versions, err := parseAnnotations("Deployment=apps/v1,Ingress=networking.k8s.io/v1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]string{"Deployment": "apps/v1", "Ingress": "networking.k8s.io/v1"}
+	if !reflect.DeepEqual(versions, want) {
+		t.Errorf("got versions %v, want %v", versions, want)
+	}
+	for _, s := range []string{"Deployment", "=apps/v1", "Deployment=apps/v1,Ingress"} {
+		if _, err := parseAnnotations(s); err == nil {
+			t.Errorf("parseAnnotations(%q) succeeded, want an error", s)
+		}
+	}
+}
@@ -0,0 +1,446 @@
+/*
+Copyright 2015 Kelsey Hightower All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/fkautz/compose2kube/convert"
+	"github.com/ghodss/yaml"
+	"github.com/pmezard/go-difflib/difflib"
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+)
+
+// kindOrder is the position of each kind of object in the apply order. The
+// output files are prefixed with it so that kubectl apply -f, which applies
+// the files of a directory alphabetically, creates dependencies first.
+var kindOrder = map[string]int{
+	"Namespace":             0,
+	"LimitRange":            5,
+	"NetworkPolicy":         5,
+	"ResourceQuota":         5,
+	"Secret":                10,
+	"ConfigMap":             10,
+	"PersistentVolumeClaim": 15,
+	"Service":               20,
+	"Endpoints":             20,
+	"ReplicationController": 30,
+	"Deployment":            30,
+	"Job":                   30,
+	"Pod":                   30,
+	"StatefulSet":           30,
+	"Ingress":               35,
+}
+
+// defaultKindOrder is the position of kinds missing from kindOrder, such as
+// custom resources.
+const defaultKindOrder = 40
+
+// kindPosition returns the position of kind in the apply order.
+func kindPosition(kind string) int {
+	position, ok := kindOrder[kind]
+	if !ok {
+		return defaultKindOrder
+	}
+	return position
+}
+
+// kindPrefix returns the file name prefix for objects of kind.
+func kindPrefix(kind string) string {
+	return fmt.Sprintf("%02d-", kindPosition(kind))
+}
+
+// parseKindOrder overrides entries of kindOrder from a comma-separated list
+// of Kind=position pairs.
+func parseKindOrder(s string) error {
+	for _, entry := range strings.Split(s, ",") {
+		parts := strings.SplitN(strings.TrimSpace(entry), "=", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return fmt.Errorf("invalid entry %q, must be Kind=position", entry)
+		}
+		position, err := strconv.Atoi(parts[1])
+		if err != nil || position < 0 || position > 99 {
+			return fmt.Errorf("invalid position %q for %s, must be between 0 and 99", parts[1], parts[0])
+		}
+		kindOrder[parts[0]] = position
+	}
+	return nil
+}
+
+// dirTemplateData is the data available to the -dir-template template.
+type dirTemplateData struct {
+	Service string
+}
+
+// parseDirTemplate parses the -dir-template template and checks that it
+// renders for a service.
+func parseDirTemplate(s string) (*template.Template, error) {
+	tmpl, err := template.New("dir-template").Parse(s)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := serviceDir(tmpl, "service"); err != nil {
+		return nil, err
+	}
+	return tmpl, nil
+}
+
+// serviceDir renders the output directory for the named service.
+func serviceDir(tmpl *template.Template, name string) (string, error) {
+	if tmpl == nil {
+		return outputDir, nil
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, dirTemplateData{Service: name}); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// objectDir returns the directory to save the objects of service to and
+// creates it. Objects shared by the project, which have no service, go to the
+// output directory.
+func objectDir(tmpl *template.Template, service string) (string, error) {
+	if service == "" {
+		return outputDir, nil
+	}
+	dir, err := serviceDir(tmpl, service)
+	if err != nil {
+		return "", err
+	}
+	if diff || archive != nil {
+		return dir, nil
+	}
+	return dir, mkdirAll(dir)
+}
+
+// parseMode parses an octal permission mode such as 0700.
+func parseMode(s string) (os.FileMode, error) {
+	mode, err := strconv.ParseUint(s, 8, 32)
+	if err != nil || mode > 0777 {
+		return 0, fmt.Errorf("%q is not an octal permission mode", s)
+	}
+	return os.FileMode(mode), nil
+}
+
+// executableMode adds the execute permission to mode for everyone allowed to
+// read.
+func executableMode(mode os.FileMode) os.FileMode {
+	return mode | (mode&0444)>>2
+}
+
+// mkdirAll creates dir and its missing parents. dir is given the -dir-mode
+// permissions regardless of the umask. A file in the way of dir and a
+// directory that cannot be written to are reported as such, rather than by
+// the failing system call.
+func mkdirAll(dir string) error {
+	if info, err := os.Stat(dir); err == nil && !info.IsDir() {
+		return fmt.Errorf("output path %s exists and is not a directory", dir)
+	}
+	if err := os.MkdirAll(dir, dirMode); err != nil {
+		if os.IsPermission(err) {
+			return fmt.Errorf("no permission to create %s", dir)
+		}
+		return err
+	}
+	if err := os.Chmod(dir, dirMode); err != nil {
+		if os.IsPermission(err) {
+			return fmt.Errorf("no permission to change the mode of %s to %04o", dir, dirMode)
+		}
+		return err
+	}
+	return checkWritable(dir)
+}
+
+// checkWritable checks that files can be created in dir by creating and
+// removing one.
+func checkWritable(dir string) error {
+	f, err := ioutil.TempFile(dir, ".compose2kube-")
+	if err != nil {
+		if os.IsPermission(err) {
+			return fmt.Errorf("output directory %s is not writable", dir)
+		}
+		return err
+	}
+	f.Close()
+	return os.Remove(f.Name())
+}
+
+// writeFile writes data to the file at path with the permissions perm,
+// regardless of the umask and of the permissions of an existing file.
+func writeFile(path string, data []byte, perm os.FileMode) error {
+	if err := ioutil.WriteFile(path, data, perm); err != nil {
+		return err
+	}
+	return os.Chmod(path, perm)
+}
+
+// Output formats of the generated objects.
+const (
+	formatJSON = "json"
+	formatYAML = "yaml"
+)
+
+// parseOutputFormats parses a comma-separated list of output formats.
+func parseOutputFormats(s string) ([]string, error) {
+	var formats []string
+	seen := make(map[string]bool)
+	for _, format := range strings.Split(s, ",") {
+		format = strings.TrimSpace(format)
+		if format != formatJSON && format != formatYAML {
+			return nil, fmt.Errorf("unknown format %q, must be json or yaml", format)
+		}
+		if !seen[format] {
+			formats = append(formats, format)
+			seen[format] = true
+		}
+	}
+	return formats, nil
+}
+
+// writeObject saves obj to a file named baseName in dir for each of the
+// output formats. The file names are prefixed with the apply order of kind.
+// The paths of the written files are printed and returned.
+func writeObject(dir, kind, baseName string, obj interface{}) ([]string, error) {
+	return writeFiles(dir, kindPrefix(kind)+baseName, obj)
+}
+
+// writeList saves objs to a file named list in dir for each of the output
+// formats, as a List ordered by the apply order of the kinds. The paths of
+// the written files are printed and returned.
+func writeList(dir string, objs []convert.Object) ([]string, error) {
+	sorted := make([]convert.Object, len(objs))
+	copy(sorted, objs)
+	sort.Stable(objectsByKindOrder(sorted))
+
+	list := &api.List{
+		TypeMeta: unversioned.TypeMeta{
+			Kind:       "List",
+			APIVersion: "v1",
+		},
+	}
+	for _, obj := range sorted {
+		list.Items = append(list.Items, obj.Object)
+	}
+	return writeFiles(dir, "list", list)
+}
+
+// writeFiles saves obj to a file named baseName in dir for each of the output
+// formats. The YAML is converted from the JSON encoding so that both formats
+// hold the same object. The paths of the written files are printed and
+// returned.
+func writeFiles(dir, baseName string, obj interface{}) ([]string, error) {
+	data, err := json.MarshalIndent(obj, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	var paths []string
+	for _, format := range outputFormats {
+		out := data
+		if format == formatYAML {
+			if out, err = yaml.JSONToYAML(data); err != nil {
+				return nil, err
+			}
+		}
+		outputFilePath := filepath.Join(dir, baseName+"."+format)
+		if err := saveFile(outputFilePath, out, fileMode); err != nil {
+			return nil, err
+		}
+		paths = append(paths, outputFilePath)
+	}
+	return paths, nil
+}
+
+// diffed holds the paths of the files compared by -diff, so that the
+// manifests that would no longer be generated can be told apart.
+var diffed = make(map[string]bool)
+
+// saveFile writes data to the file at path and prints the path. With
+// -output-archive the file is added to the archive instead. With -diff the
+// file is left alone, and a unified diff of the changes to it is printed
+// instead, if any.
+func saveFile(path string, data []byte, perm os.FileMode) error {
+	if archive != nil {
+		if err := archive.add(path, data, perm); err != nil {
+			return err
+		}
+		fmt.Println(path)
+		return nil
+	}
+	if !diff {
+		if err := writeFile(path, data, perm); err != nil {
+			return err
+		}
+		fmt.Println(path)
+		return nil
+	}
+
+	diffed[filepath.Clean(path)] = true
+	existing, err := ioutil.ReadFile(path)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	if bytes.Equal(existing, data) {
+		return nil
+	}
+	differences = true
+	fromFile := path
+	if existing == nil {
+		fromFile = "/dev/null"
+	}
+	text, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(string(existing)),
+		B:        difflib.SplitLines(string(data)),
+		FromFile: fromFile,
+		ToFile:   path,
+		Context:  3,
+	})
+	if err != nil {
+		return err
+	}
+	fmt.Print(text)
+	return nil
+}
+
+// diffRemovals prints a unified diff removing each manifest below dir, a
+// JSON or YAML file, that -diff did not compare as it would no longer be
+// generated, such as the objects of a service that was removed or skipped.
+func diffRemovals(dir string) error {
+	return filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			if path == dir && os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		if info.IsDir() || diffed[filepath.Clean(path)] {
+			return nil
+		}
+		if ext := filepath.Ext(path); ext != "."+formatJSON && ext != "."+formatYAML {
+			return nil
+		}
+		existing, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		differences = true
+		text, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+			A:        difflib.SplitLines(string(existing)),
+			FromFile: path,
+			ToFile:   "/dev/null",
+			Context:  3,
+		})
+		if err != nil {
+			return err
+		}
+		fmt.Print(text)
+		return nil
+	})
+}
+
+// manifestPath returns the path of a written manifest relative to the output
+// directory, which is where tools such as Skaffold resolve it from.
+func manifestPath(outputFilePath string) string {
+	manifest, err := filepath.Rel(outputDir, outputFilePath)
+	if err != nil {
+		manifest, _ = filepath.Abs(outputFilePath)
+	}
+	return manifest
+}
+
+// writeBundle saves objs to fileName in dir as a multi-document YAML file,
+// ordered by the apply order of their kinds. The path of the written file is
+// printed and returned.
+func writeBundle(dir, fileName string, objs []convert.Object) (string, error) {
+	data, err := yamlDocuments(objs)
+	if err != nil {
+		return "", err
+	}
+	outputFilePath := filepath.Join(dir, fileName)
+	if err := saveFile(outputFilePath, data, fileMode); err != nil {
+		return "", err
+	}
+	return outputFilePath, nil
+}
+
+// stdoutDir is the output directory that prints the objects instead.
+const stdoutDir = "-"
+
+// writeStdout prints objs to w as a multi-document YAML stream, for a quick
+// look at the objects of a single service. Converting more than one service
+// is an error, as their objects are better read from separate files.
+func writeStdout(w io.Writer, objs []convert.Object) error {
+	var services []string
+	seen := make(map[string]bool)
+	for _, obj := range objs {
+		if obj.Service != "" && !seen[obj.Service] {
+			services = append(services, obj.Service)
+			seen[obj.Service] = true
+		}
+	}
+	if len(services) != 1 {
+		return fmt.Errorf("-output-dir - prints a single service, but %d services were converted, select one with -only", len(services))
+	}
+
+	data, err := yamlDocuments(objs)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(data)
+	return err
+}
+
+// yamlDocuments encodes objs as a multi-document YAML stream, ordered by the
+// apply order of their kinds.
+func yamlDocuments(objs []convert.Object) ([]byte, error) {
+	sorted := make([]convert.Object, len(objs))
+	copy(sorted, objs)
+	sort.Stable(objectsByKindOrder(sorted))
+
+	var buf bytes.Buffer
+	for i, obj := range sorted {
+		if i > 0 {
+			buf.WriteString("---\n")
+		}
+		data, err := json.Marshal(obj.Object)
+		if err != nil {
+			return nil, err
+		}
+		data, err = yaml.JSONToYAML(data)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(data)
+	}
+	return buf.Bytes(), nil
+}
+
+type objectsByKindOrder []convert.Object
+
+func (o objectsByKindOrder) Len() int      { return len(o) }
+func (o objectsByKindOrder) Swap(i, j int) { o[i], o[j] = o[j], o[i] }
+func (o objectsByKindOrder) Less(i, j int) bool {
+	return kindPosition(o[i].Kind) < kindPosition(o[j].Kind)
+}
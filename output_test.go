@@ -0,0 +1,435 @@
+/*
+Copyright 2015 Kelsey Hightower All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/fkautz/compose2kube/convert"
+	"github.com/ghodss/yaml"
+)
+
+// multiKind converts to a secret, a claim, a service and two controllers.
+const multiKind = `version: "3.3"
+services:
+  web:
+    image: nginx
+    ports:
+      - "80"
+    labels:
+      kompose.service.kind: webapp
+  database:
+    image: postgres
+    secrets:
+      - password
+    volumes:
+      - type: volume
+        source: dbdata
+        target: /var/lib/postgresql/data
+secrets:
+  password:
+    file: ./password.txt
+volumes:
+  dbdata:
+`
+
+// convertMultiKind converts multiKind with opts.
+func convertMultiKind(t *testing.T, opts convert.Options) *convert.Result {
+	t.Helper()
+	composeFile := writeCompose(t, multiKind)
+	if err := ioutil.WriteFile(filepath.Join(filepath.Dir(composeFile), "password.txt"), []byte("secret"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	result, err := convert.Convert(composeFile, opts)
+	if err != nil {
+		t.Fatalf("Convert: %v", err)
+	}
+	return result
+}
+
+func TestApplyOrderPrefixes(t *testing.T) {
+	dir := withOutput(t, formatYAML)
+	writeObjects(convertMultiKind(t, convert.Options{}).Objects, nil)
+
+	want := []string{
+		"10-password-secret.yaml",
+		"15-dbdata-pvc.yaml",
+		"20-web-svc.yaml",
+		"30-database-rc.yaml",
+		"30-web-deployment.yaml",
+	}
+	if got := listFiles(t, dir); !reflect.DeepEqual(got, want) {
+		t.Errorf("got files %q, want %q", got, want)
+	}
+}
+
+func TestKindOrderOverride(t *testing.T) {
+	saved := make(map[string]int, len(kindOrder))
+	for kind, position := range kindOrder {
+		saved[kind] = position
+	}
+	defer func() { kindOrder = saved }()
+
+	if err := parseKindOrder("Secret=05, Service=25"); err != nil {
+		t.Fatal(err)
+	}
+	tests := map[string]string{
+		"Secret":                "05-",
+		"Service":               "25-",
+		"ConfigMap":             "10-",
+		"ReplicationController": "30-",
+		"Widget":                "40-",
+	}
+	for kind, want := range tests {
+		if got := kindPrefix(kind); got != want {
+			t.Errorf("kindPrefix(%s) = %s, want %s", kind, got, want)
+		}
+	}
+
+	for _, s := range []string{"Secret", "Secret=", "Secret=100", "Secret=-1", "=10"} {
+		if err := parseKindOrder(s); err == nil {
+			t.Errorf("parseKindOrder(%q) succeeded, want an error", s)
+		}
+	}
+}
+
+func TestOutputFormats(t *testing.T) {
+	formats, err := parseOutputFormats("json, yaml,json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{formatJSON, formatYAML}; !reflect.DeepEqual(formats, want) {
+		t.Fatalf("got formats %q, want %q", formats, want)
+	}
+	if _, err := parseOutputFormats("json,toml"); err == nil {
+		t.Error("parseOutputFormats(json,toml) succeeded, want an error")
+	}
+
+	dir := withOutput(t, formats...)
+	writeObjects(convertCompose(t, twoServices).Objects, nil)
+	for _, name := range []string{"20-web-svc", "30-database-rc", "30-web-deployment"} {
+		jsonData, err := ioutil.ReadFile(filepath.Join(dir, name+".json"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		yamlData, err := ioutil.ReadFile(filepath.Join(dir, name+".yaml"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		var fromJSON, fromYAML map[string]interface{}
+		if err := json.Unmarshal(jsonData, &fromJSON); err != nil {
+			t.Fatalf("%s.json: %v", name, err)
+		}
+		if err := yaml.Unmarshal(yamlData, &fromYAML); err != nil {
+			t.Fatalf("%s.yaml: %v", name, err)
+		}
+		if !reflect.DeepEqual(fromJSON, fromYAML) {
+			t.Errorf("%s.json and %s.yaml hold different objects:\n%s\n%s", name, name, jsonData, yamlData)
+		}
+	}
+}
+
+func TestStdout(t *testing.T) {
+	result, err := convert.Convert(writeCompose(t, twoServices), convert.Options{Only: []string{"web"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err := writeStdout(&buf, result.Objects); err != nil {
+		t.Fatal(err)
+	}
+
+	// The documents are in the apply order of their kinds.
+	var objs []string
+	for _, doc := range strings.Split(buf.String(), "---\n") {
+		var obj struct {
+			Kind     string `json:"kind"`
+			Metadata struct {
+				Name string `json:"name"`
+			} `json:"metadata"`
+		}
+		if err := yaml.Unmarshal([]byte(doc), &obj); err != nil {
+			t.Fatalf("invalid document %q: %v", doc, err)
+		}
+		objs = append(objs, obj.Kind+"/"+obj.Metadata.Name)
+	}
+	if want := []string{"Service/web", "Deployment/web"}; !reflect.DeepEqual(objs, want) {
+		t.Errorf("got documents %q, want %q", objs, want)
+	}
+
+	buf.Reset()
+	err = writeStdout(&buf, convertCompose(t, twoServices).Objects)
+	if err == nil || !strings.Contains(err.Error(), "2 services were converted") {
+		t.Errorf("got error %v for two services, want one naming the 2 converted services", err)
+	}
+	if buf.Len() != 0 {
+		t.Errorf("printed %q for two services, want nothing", buf.String())
+	}
+}
+
+func TestModes(t *testing.T) {
+	withOutput(t, formatYAML)
+	var err error
+	if dirMode, err = parseMode("0750"); err != nil {
+		t.Fatal(err)
+	}
+	if fileMode, err = parseMode("640"); err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range []string{"", "rw", "0888", "1777", "-1"} {
+		if _, err := parseMode(s); err == nil {
+			t.Errorf("parseMode(%q) succeeded, want an error", s)
+		}
+	}
+
+	// The modes apply regardless of the umask and of the modes of an
+	// existing directory and file.
+	outputDir = filepath.Join(outputDir, "manifests")
+	if err := os.Mkdir(outputDir, 0777); err != nil {
+		t.Fatal(err)
+	}
+	existing := filepath.Join(outputDir, "20-web-svc.yaml")
+	if err := ioutil.WriteFile(existing, nil, 0666); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chmod(existing, 0666); err != nil {
+		t.Fatal(err)
+	}
+	if err := mkdirAll(outputDir); err != nil {
+		t.Fatal(err)
+	}
+	captureStdout(t, func() { writeObjects(convertCompose(t, twoServices).Objects, nil) })
+
+	info, err := os.Stat(outputDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := info.Mode().Perm(); got != 0750 {
+		t.Errorf("got directory mode %04o, want 0750", got)
+	}
+	for _, name := range listFiles(t, outputDir) {
+		info, err := os.Stat(filepath.Join(outputDir, name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := info.Mode().Perm(); got != 0640 {
+			t.Errorf("%s: got mode %04o, want 0640", name, got)
+		}
+	}
+
+	// The apply script is executable by everyone allowed to read it.
+	tests := map[os.FileMode]os.FileMode{
+		0644: 0755,
+		0640: 0750,
+		0600: 0700,
+		0200: 0200,
+	}
+	for mode, want := range tests {
+		if got := executableMode(mode); got != want {
+			t.Errorf("executableMode(%04o) = %04o, want %04o", mode, got, want)
+		}
+	}
+}
+
+func TestUnusableOutputDir(t *testing.T) {
+	dir := withOutput(t, formatYAML)
+	file := filepath.Join(dir, "output")
+	if err := ioutil.WriteFile(file, nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+	err := mkdirAll(file)
+	if want := "output path " + file + " exists and is not a directory"; err == nil || err.Error() != want {
+		t.Errorf("got error %v for a file in place of the directory, want %q", err, want)
+	}
+	if err := mkdirAll(filepath.Join(file, "manifests")); err == nil {
+		t.Error("mkdirAll below a file succeeded, want an error")
+	}
+
+	// Permissions do not apply to root.
+	if os.Geteuid() == 0 {
+		return
+	}
+	readOnly := filepath.Join(dir, "read-only")
+	if err := os.Mkdir(readOnly, 0555); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.Chmod(readOnly, 0755) })
+	err = mkdirAll(filepath.Join(readOnly, "manifests"))
+	if want := "no permission to create " + filepath.Join(readOnly, "manifests"); err == nil || err.Error() != want {
+		t.Errorf("got error %v below a read-only directory, want %q", err, want)
+	}
+	unwritable := filepath.Join(dir, "unwritable")
+	t.Cleanup(func() { os.Chmod(unwritable, 0755) })
+	dirMode = 0555
+	err = mkdirAll(unwritable)
+	if err == nil || !strings.HasSuffix(err.Error(), "is not writable") {
+		t.Errorf("got error %v for a -dir-mode of 0555, want the directory to be reported as not writable", err)
+	}
+}
+
+func TestList(t *testing.T) {
+	dir := withOutput(t, formatJSON)
+	if _, err := writeList(dir, convertMultiKind(t, convert.Options{}).Objects); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := ioutil.ReadFile(filepath.Join(dir, "list.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	type meta struct {
+		APIVersion string `json:"apiVersion"`
+		Kind       string `json:"kind"`
+		Metadata   struct {
+			Name string `json:"name"`
+		} `json:"metadata"`
+	}
+	var list struct {
+		meta
+		Items []meta `json:"items"`
+	}
+	if err := json.Unmarshal(data, &list); err != nil {
+		t.Fatal(err)
+	}
+	if list.APIVersion != "v1" || list.Kind != "List" {
+		t.Errorf("got %s %s, want v1 List", list.APIVersion, list.Kind)
+	}
+
+	// Dependencies come first, and objects of the same position keep the
+	// order they were generated in, which is the name order of the services.
+	var items []string
+	for _, item := range list.Items {
+		items = append(items, item.Kind+"/"+item.Metadata.Name)
+	}
+	want := []string{
+		"Secret/password",
+		"PersistentVolumeClaim/dbdata",
+		"Service/web",
+		"ReplicationController/database",
+		"Deployment/web",
+	}
+	if !reflect.DeepEqual(items, want) {
+		t.Errorf("got items %q, want %q", items, want)
+	}
+}
+
+func TestDiffRemovals(t *testing.T) {
+	dir := withOutput(t, formatYAML)
+	t.Cleanup(func() {
+		diff, differences = false, false
+		diffed = make(map[string]bool)
+	})
+	captureStdout(t, func() {
+		writeObjects(convertCompose(t, twoServices).Objects, nil)
+	})
+
+	// Converting the same services again finds no changes.
+	diff = true
+	result, err := convert.Convert(writeCompose(t, twoServices), convert.Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	out := captureStdout(t, func() {
+		writeObjects(result.Objects, nil)
+		if err := diffRemovals(dir); err != nil {
+			t.Fatal(err)
+		}
+	})
+	if out != "" || differences {
+		t.Fatalf("got differences for an unchanged conversion:\n%s", out)
+	}
+
+	// Skipping a service removes its manifest, which stays on disk.
+	diffed = make(map[string]bool)
+	result, err = convert.Convert(writeCompose(t, twoServices), convert.Options{Skip: []string{"database"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	out = captureStdout(t, func() {
+		writeObjects(result.Objects, nil)
+		if err := diffRemovals(dir); err != nil {
+			t.Fatal(err)
+		}
+	})
+	removed := filepath.Join(dir, "30-database-rc.yaml")
+	if !differences {
+		t.Errorf("got no differences after skipping a service")
+	}
+	if want := "--- " + removed + "\n+++ /dev/null\n"; !strings.HasPrefix(out, want) {
+		t.Errorf("got diff:\n%s\nwant it to start with:\n%s", out, want)
+	}
+	if strings.Contains(out, "web") {
+		t.Errorf("got a diff for the unchanged web manifests:\n%s", out)
+	}
+	if _, err := os.Stat(removed); err != nil {
+		t.Errorf("-diff changed the output directory: %v", err)
+	}
+}
+
+func TestPerServiceBundle(t *testing.T) {
+	dir := withOutput(t, formatYAML)
+	bundle = true
+	t.Cleanup(func() { bundle = false })
+
+	composeFile := writeCompose(t, `version: "2"
+services:
+  web:
+    image: nginx
+    ports:
+      - "80"
+    labels:
+      kompose.service.kind: webapp
+    volumes:
+      - ./nginx.conf:/etc/nginx/nginx.conf:ro
+`)
+	if err := ioutil.WriteFile(filepath.Join(filepath.Dir(composeFile), "nginx.conf"), []byte("worker_processes 1;\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	result, err := convert.Convert(composeFile, convert.Options{ConfigMapFiles: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	captureStdout(t, func() { writeObjects(result.Objects, nil) })
+
+	if got, want := listFiles(t, dir), []string{"web.yaml"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got files %q, want %q", got, want)
+	}
+	data, err := ioutil.ReadFile(filepath.Join(dir, "web.yaml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The documents are separated by --- and in the apply order of their
+	// kinds.
+	var kinds []string
+	for _, doc := range strings.Split(string(data), "---\n") {
+		var obj struct {
+			Kind string `json:"kind"`
+		}
+		if err := yaml.Unmarshal([]byte(doc), &obj); err != nil {
+			t.Fatalf("invalid document %q: %v", doc, err)
+		}
+		kinds = append(kinds, obj.Kind)
+	}
+	if want := []string{"ConfigMap", "Service", "Deployment"}; !reflect.DeepEqual(kinds, want) {
+		t.Errorf("got documents of kinds %q, want %q", kinds, want)
+	}
+}
@@ -0,0 +1,60 @@
+/*
+Copyright 2015 Kelsey Hightower All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/kubernetes/pkg/util/validation"
+)
+
+// project is an independent compose project converted with -project.
+type project struct {
+	Name        string
+	ComposeFile string
+}
+
+// projectList is the value of the repeatable -project flag, in the order
+// the projects were given.
+type projectList []project
+
+// String implements flag.Value.
+func (l *projectList) String() string {
+	entries := make([]string, 0, len(*l))
+	for _, p := range *l {
+		entries = append(entries, p.Name+"="+p.ComposeFile)
+	}
+	return strings.Join(entries, ",")
+}
+
+// Set implements flag.Value, adding a project given as name=path. The name
+// is used as a name prefix and output directory, so it must be a DNS label.
+func (l *projectList) Set(value string) error {
+	parts := strings.SplitN(value, "=", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return fmt.Errorf("invalid project %q, must be name=path", value)
+	}
+	name := parts[0]
+	if errs := validation.IsDNS1123Label(name); len(errs) > 0 {
+		return fmt.Errorf("invalid project name %s: %s", name, strings.Join(errs, ", "))
+	}
+	for _, p := range *l {
+		if p.Name == name {
+			return fmt.Errorf("project %s is given more than once", name)
+		}
+	}
+	*l = append(*l, project{Name: name, ComposeFile: parts[1]})
+	return nil
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2015 Kelsey Hightower All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/fkautz/compose2kube/convert"
+	"github.com/ghodss/yaml"
+)
+
+func TestProjects(t *testing.T) {
+	dir := withOutput(t, formatYAML)
+	var projects projectList
+	for _, value := range []string{
+		"shop=" + writeCompose(t, twoServices),
+		"blog=" + writeCompose(t, twoServices),
+	} {
+		if err := projects.Set(value); err != nil {
+			t.Fatalf("Set(%q): %v", value, err)
+		}
+	}
+	convertProjects(projects, convert.Options{}, nil)
+
+	want := []string{
+		"blog/20-blog-web-svc.yaml",
+		"blog/30-blog-database-rc.yaml",
+		"blog/30-blog-web-deployment.yaml",
+		"shop/20-shop-web-svc.yaml",
+		"shop/30-shop-database-rc.yaml",
+		"shop/30-shop-web-deployment.yaml",
+	}
+	if got := listFiles(t, dir); !reflect.DeepEqual(got, want) {
+		t.Fatalf("got files %q, want %q", got, want)
+	}
+	if outputDir != dir {
+		t.Errorf("got output directory %s after the conversion, want %s", outputDir, dir)
+	}
+
+	// The objects of each project are prefixed with its name.
+	data, err := ioutil.ReadFile(filepath.Join(dir, "shop", "30-shop-database-rc.yaml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var rc struct {
+		Metadata struct {
+			Name string `json:"name"`
+		} `json:"metadata"`
+	}
+	if err := yaml.Unmarshal(data, &rc); err != nil {
+		t.Fatal(err)
+	}
+	if rc.Metadata.Name != "shop-database" {
+		t.Errorf("got name %s, want shop-database", rc.Metadata.Name)
+	}
+}
+
+func TestProjectListSet(t *testing.T) {
+	var projects projectList
+	if err := projects.Set("shop=shop/docker-compose.yml"); err != nil {
+		t.Fatal(err)
+	}
+	for _, value := range []string{
+		"shop=other/docker-compose.yml",
+		"Shop=shop/docker-compose.yml",
+		"shop",
+		"=docker-compose.yml",
+		"blog=",
+	} {
+		if err := projects.Set(value); err == nil {
+			t.Errorf("Set(%q) succeeded, want an error", value)
+		}
+	}
+	if got := projects.String(); got != "shop=shop/docker-compose.yml" {
+		t.Errorf("got projects %s, want shop=shop/docker-compose.yml", got)
+	}
+}
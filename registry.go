@@ -0,0 +1,316 @@
+/*
+Copyright 2015 Kelsey Hightower All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/fkautz/compose2kube/convert"
+)
+
+// Docker Hub, which images without a registry are pulled from, and the key
+// of its credentials in the Docker config.
+const (
+	dockerHubRegistry = "registry-1.docker.io"
+	dockerHubAuthKey  = "https://index.docker.io/v1/"
+)
+
+// dockerHubAliases are the names Docker Hub is also referred to by in image
+// references, which do not serve the registry API.
+var dockerHubAliases = map[string]bool{
+	"docker.io":       true,
+	"index.docker.io": true,
+}
+
+// manifestMediaTypes are the manifest formats accepted when checking an image,
+// so that registries answer for single and multi-platform images alike.
+var manifestMediaTypes = []string{
+	"application/vnd.docker.distribution.manifest.v2+json",
+	"application/vnd.docker.distribution.manifest.list.v2+json",
+	"application/vnd.oci.image.manifest.v1+json",
+	"application/vnd.oci.image.index.v1+json",
+}
+
+// imageReference is an image reference split into the registry host, the
+// repository in that registry and the tag or digest.
+type imageReference struct {
+	Registry   string
+	Repository string
+	Reference  string
+}
+
+// parseImage splits an image reference such as nginx, my/app:1.0 or
+// registry.example.com:5000/team/app@sha256:... like Docker does. Images
+// without a registry or on docker.io are on Docker Hub, where official images
+// are in the library namespace, and images without a tag are latest.
+func parseImage(image string) (imageReference, error) {
+	ref := imageReference{Registry: dockerHubRegistry, Reference: "latest"}
+	name := image
+	if i := strings.Index(name, "@"); i >= 0 {
+		name, ref.Reference = name[:i], name[i+1:]
+	} else if i := strings.LastIndex(name, ":"); i > strings.LastIndex(name, "/") {
+		name, ref.Reference = name[:i], name[i+1:]
+	}
+	if i := strings.Index(name, "/"); i >= 0 {
+		host := name[:i]
+		if strings.ContainsAny(host, ".:") || host == "localhost" {
+			ref.Registry, name = host, name[i+1:]
+		}
+		if dockerHubAliases[ref.Registry] {
+			ref.Registry = dockerHubRegistry
+		}
+	}
+	if ref.Registry == dockerHubRegistry && !strings.Contains(name, "/") {
+		name = "library/" + name
+	}
+	if name == "" || ref.Reference == "" {
+		return imageReference{}, fmt.Errorf("invalid image reference %q", image)
+	}
+	ref.Repository = name
+	return ref, nil
+}
+
+// registryAuths returns the credentials of the auths section of the Docker
+// config, keyed by registry, as base64 encoded user:password pairs.
+// Credential helpers are not consulted.
+func registryAuths() map[string]string {
+	dir := os.Getenv("DOCKER_CONFIG")
+	if dir == "" {
+		dir = filepath.Join(os.Getenv("HOME"), ".docker")
+	}
+	data, err := ioutil.ReadFile(filepath.Join(dir, "config.json"))
+	if err != nil {
+		return nil
+	}
+	var config struct {
+		Auths map[string]struct {
+			Auth string `json:"auth"`
+		} `json:"auths"`
+	}
+	if err := json.Unmarshal(data, &config); err != nil {
+		return nil
+	}
+	auths := make(map[string]string)
+	for key, auth := range config.Auths {
+		if auth.Auth == "" {
+			continue
+		}
+		if key == dockerHubAuthKey {
+			key = dockerHubRegistry
+		}
+		key = strings.TrimPrefix(strings.TrimPrefix(key, "https://"), "http://")
+		auths[strings.TrimSuffix(key, "/")] = auth.Auth
+	}
+	return auths
+}
+
+// imageChecker checks that images exist in their registries.
+type imageChecker struct {
+	client  *http.Client
+	auths   map[string]string
+	timeout time.Duration
+	// insecure holds the registries served over plain HTTP rather than
+	// HTTPS.
+	insecure map[string]bool
+}
+
+// newImageChecker creates an image checker using the credentials of the
+// Docker config, with each check limited to timeout. The insecure registries
+// are checked over plain HTTP.
+func newImageChecker(timeout time.Duration, insecure []string) *imageChecker {
+	c := &imageChecker{client: http.DefaultClient, auths: registryAuths(), timeout: timeout, insecure: make(map[string]bool)}
+	for _, registry := range insecure {
+		c.insecure[registry] = true
+	}
+	return c
+}
+
+// scheme returns the URL scheme registry is reached with.
+func (c *imageChecker) scheme(registry string) string {
+	if c.insecure[registry] {
+		return "http"
+	}
+	return "https"
+}
+
+// check requests the manifest of image from its registry. It returns an error
+// when the image or tag does not exist, or when the registry cannot tell.
+func (c *imageChecker) check(image string) error {
+	ref, err := parseImage(image)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+
+	manifestURL := fmt.Sprintf("%s://%s/v2/%s/manifests/%s", c.scheme(ref.Registry), ref.Registry, ref.Repository, ref.Reference)
+	resp, err := c.head(ctx, manifestURL, "")
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode == http.StatusUnauthorized {
+		authorization, err := c.authorize(ctx, ref, resp.Header.Get("WWW-Authenticate"))
+		if err != nil {
+			return fmt.Errorf("failed to authenticate to %s: %v", ref.Registry, err)
+		}
+		if resp, err = c.head(ctx, manifestURL, authorization); err != nil {
+			return err
+		}
+	}
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return nil
+	case http.StatusNotFound:
+		return fmt.Errorf("%s not found in %s", ref.Repository+referenceSeparator(ref.Reference)+ref.Reference, ref.Registry)
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return fmt.Errorf("access to %s denied by %s, it may not exist or need credentials", ref.Repository, ref.Registry)
+	default:
+		return fmt.Errorf("unexpected status %s from %s", resp.Status, ref.Registry)
+	}
+}
+
+// head sends a HEAD request for a manifest with the given Authorization
+// header, if any.
+func (c *imageChecker) head(ctx context.Context, manifestURL, authorization string) (*http.Response, error) {
+	req, err := http.NewRequest("HEAD", manifestURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", strings.Join(manifestMediaTypes, ", "))
+	if authorization != "" {
+		req.Header.Set("Authorization", authorization)
+	}
+	resp, err := c.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	resp.Body.Close()
+	return resp, nil
+}
+
+// challengeParams matches the parameters of a WWW-Authenticate challenge.
+var challengeParams = regexp.MustCompile(`(\w+)="([^"]*)"`)
+
+// authorize answers the authentication challenge of a registry, returning
+// the Authorization header to retry with. Bearer challenges are answered
+// with a token pulled from the realm of the challenge, authenticated with the
+// credentials of the registry if there are any.
+func (c *imageChecker) authorize(ctx context.Context, ref imageReference, challenge string) (string, error) {
+	auth := c.auths[ref.Registry]
+	scheme := strings.ToLower(strings.SplitN(challenge, " ", 2)[0])
+	switch scheme {
+	case "basic":
+		if auth == "" {
+			return "", fmt.Errorf("no credentials in the Docker config")
+		}
+		return "Basic " + auth, nil
+	case "bearer":
+	default:
+		return "", fmt.Errorf("unsupported authentication challenge %q", challenge)
+	}
+
+	params := make(map[string]string)
+	for _, match := range challengeParams.FindAllStringSubmatch(challenge, -1) {
+		params[match[1]] = match[2]
+	}
+	if params["realm"] == "" {
+		return "", fmt.Errorf("no realm in the authentication challenge %q", challenge)
+	}
+	query := url.Values{}
+	if service := params["service"]; service != "" {
+		query.Set("service", service)
+	}
+	query.Set("scope", fmt.Sprintf("repository:%s:pull", ref.Repository))
+	req, err := http.NewRequest("GET", params["realm"]+"?"+query.Encode(), nil)
+	if err != nil {
+		return "", err
+	}
+	if auth != "" {
+		req.Header.Set("Authorization", "Basic "+auth)
+	}
+	resp, err := c.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("unexpected status %s from %s", resp.Status, params["realm"])
+	}
+	var token struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&token); err != nil {
+		return "", err
+	}
+	if token.Token == "" {
+		token.Token = token.AccessToken
+	}
+	return "Bearer " + token.Token, nil
+}
+
+// referenceSeparator returns the separator between a repository and a tag or
+// digest.
+func referenceSeparator(reference string) string {
+	if strings.Contains(reference, ":") {
+		return "@"
+	}
+	return ":"
+}
+
+// checkImages checks that the images of the converted workloads exist,
+// leaving out the images built from the compose file, which are only pushed
+// after the conversion. Missing images are logged as warnings, or fail the
+// conversion with -strict. The insecure registries are checked over plain
+// HTTP.
+func checkImages(result *convert.Result, timeout time.Duration, insecure []string) {
+	built := make(map[string]bool)
+	for _, build := range result.Builds {
+		built[build.Image] = true
+	}
+	checker := newImageChecker(timeout, insecure)
+	checked := make(map[string]bool)
+	failed := false
+	for _, obj := range result.Objects {
+		spec := podSpec(obj.Object)
+		if spec == nil {
+			continue
+		}
+		for _, container := range spec.Containers {
+			image := container.Image
+			if image == "" || built[image] || checked[image] {
+				continue
+			}
+			checked[image] = true
+			if err := checker.check(image); err != nil {
+				log.Printf("Warning: image %s of service %s: %v", image, obj.Service, err)
+				failed = true
+			}
+		}
+	}
+	if failed && strict {
+		log.Fatalf("Some images could not be found")
+	}
+}
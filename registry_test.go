@@ -0,0 +1,117 @@
+/*
+Copyright 2015 Kelsey Hightower All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseImage(t *testing.T) {
+	tests := []struct {
+		image string
+		want  imageReference
+	}{
+		{"nginx", imageReference{dockerHubRegistry, "library/nginx", "latest"}},
+		{"my/app:1.0", imageReference{dockerHubRegistry, "my/app", "1.0"}},
+		{"docker.io/nginx:1.25", imageReference{dockerHubRegistry, "library/nginx", "1.25"}},
+		{"index.docker.io/my/app", imageReference{dockerHubRegistry, "my/app", "latest"}},
+		{"localhost/app", imageReference{"localhost", "app", "latest"}},
+		{"registry.example.com:5000/team/app@sha256:abc", imageReference{"registry.example.com:5000", "team/app", "sha256:abc"}},
+	}
+	for _, tt := range tests {
+		got, err := parseImage(tt.image)
+		if err != nil {
+			t.Errorf("%s: %v", tt.image, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("%s: got %+v, want %+v", tt.image, got, tt.want)
+		}
+	}
+	for _, image := range []string{"app:", "app@"} {
+		if _, err := parseImage(image); err == nil {
+			t.Errorf("%s: got no error", image)
+		}
+	}
+}
+
+// fakeRegistry serves the manifests of the given images over plain HTTP,
+// behind a bearer token handed out by its own realm.
+func fakeRegistry(t *testing.T, images ...string) *httptest.Server {
+	t.Helper()
+	const token = "secret-token"
+	exists := make(map[string]bool)
+	for _, image := range images {
+		exists[image] = true
+	}
+	mux := http.NewServeMux()
+	var server *httptest.Server
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasPrefix(r.URL.Query().Get("scope"), "repository:") {
+			http.Error(w, "no scope", http.StatusBadRequest)
+			return
+		}
+		fmt.Fprintf(w, `{"token": %q}`, token)
+	})
+	mux.HandleFunc("/v2/", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer "+token {
+			w.Header().Set("WWW-Authenticate", fmt.Sprintf(`Bearer realm="%s/token",service="fake"`, server.URL))
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		parts := strings.SplitN(strings.TrimPrefix(r.URL.Path, "/v2/"), "/manifests/", 2)
+		if r.Method != "HEAD" || len(parts) != 2 || !exists[parts[0]+":"+parts[1]] {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+	server = httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestImageChecker(t *testing.T) {
+	server := fakeRegistry(t, "team/app:1.0")
+	u, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	registry := u.Host
+
+	checker := newImageChecker(time.Second, []string{registry})
+	checker.client = server.Client()
+	checker.auths = nil
+	if err := checker.check(registry + "/team/app:1.0"); err != nil {
+		t.Errorf("existing image: %v", err)
+	}
+	err = checker.check(registry + "/team/app:2.0")
+	if want := "team/app:2.0 not found in " + registry; err == nil || err.Error() != want {
+		t.Errorf("missing image: got error %v, want %s", err, want)
+	}
+
+	// Without being listed as insecure, the registry is reached over HTTPS,
+	// which the fake registry does not serve.
+	checker = newImageChecker(time.Second, nil)
+	checker.client = server.Client()
+	if err := checker.check(registry + "/team/app:1.0"); err == nil {
+		t.Errorf("got no error checking a plain HTTP registry over HTTPS")
+	}
+}
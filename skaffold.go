@@ -0,0 +1,73 @@
+/*
+Copyright 2015 Kelsey Hightower All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"sort"
+
+	"gopkg.in/yaml.v2"
+)
+
+// skaffoldConfig is the subset of the Skaffold configuration schema needed to
+// build the compose images and deploy the generated manifests.
+type skaffoldConfig struct {
+	APIVersion string         `yaml:"apiVersion"`
+	Kind       string         `yaml:"kind"`
+	Build      skaffoldBuild  `yaml:"build,omitempty"`
+	Deploy     skaffoldDeploy `yaml:"deploy"`
+}
+
+type skaffoldBuild struct {
+	Artifacts []skaffoldArtifact `yaml:"artifacts,omitempty"`
+}
+
+type skaffoldArtifact struct {
+	Image   string                `yaml:"image"`
+	Context string                `yaml:"context,omitempty"`
+	Docker  *skaffoldDockerConfig `yaml:"docker,omitempty"`
+}
+
+type skaffoldDockerConfig struct {
+	Dockerfile string `yaml:"dockerfile,omitempty"`
+}
+
+type skaffoldDeploy struct {
+	Kubectl skaffoldKubectl `yaml:"kubectl"`
+}
+
+type skaffoldKubectl struct {
+	Manifests []string `yaml:"manifests"`
+}
+
+// newSkaffoldConfig returns a Skaffold configuration that builds artifacts and
+// deploys manifests. Manifests are listed in sorted order so the output is
+// stable between runs.
+func newSkaffoldConfig(artifacts []skaffoldArtifact, manifests []string) ([]byte, error) {
+	sorted := append([]string{}, manifests...)
+	sort.Strings(sorted)
+	sort.Sort(artifactsByImage(artifacts))
+
+	return yaml.Marshal(skaffoldConfig{
+		APIVersion: "skaffold/v2beta29",
+		Kind:       "Config",
+		Build:      skaffoldBuild{Artifacts: artifacts},
+		Deploy:     skaffoldDeploy{Kubectl: skaffoldKubectl{Manifests: sorted}},
+	})
+}
+
+type artifactsByImage []skaffoldArtifact
+
+func (a artifactsByImage) Len() int           { return len(a) }
+func (a artifactsByImage) Swap(i, j int)      { a[i], a[j] = a[j], a[i] }
+func (a artifactsByImage) Less(i, j int) bool { return a[i].Image < a[j].Image }
@@ -0,0 +1,66 @@
+/*
+Copyright 2015 Kelsey Hightower All rights reserved.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/fkautz/compose2kube/convert"
+)
+
+// sharedSummaryName stands for the objects shared by the project in the
+// summary, such as secrets, which belong to no service.
+const sharedSummaryName = "(shared)"
+
+// writeSummary writes a table of the kinds of objects generated for each
+// service to w, in the order the services were converted, for example:
+//
+//	(shared)  Secret
+//	web       Service, Deployment
+//	db        ConfigMap (2), ReplicationController
+func writeSummary(w io.Writer, objs []convert.Object) error {
+	var services []string
+	kinds := make(map[string][]string)
+	counts := make(map[string]map[string]int)
+	for _, obj := range objs {
+		service := obj.Service
+		if service == "" {
+			service = sharedSummaryName
+		}
+		if _, ok := counts[service]; !ok {
+			services = append(services, service)
+			counts[service] = make(map[string]int)
+		}
+		if counts[service][obj.Kind] == 0 {
+			kinds[service] = append(kinds[service], obj.Kind)
+		}
+		counts[service][obj.Kind]++
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	for _, service := range services {
+		var entries []string
+		for _, kind := range kinds[service] {
+			if n := counts[service][kind]; n > 1 {
+				kind = fmt.Sprintf("%s (%d)", kind, n)
+			}
+			entries = append(entries, kind)
+		}
+		fmt.Fprintf(tw, "%s\t%s\n", service, strings.Join(entries, ", "))
+	}
+	return tw.Flush()
+}
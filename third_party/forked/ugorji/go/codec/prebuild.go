@@ -0,0 +1,3 @@
+package codec
+
+//go:generate bash prebuild.sh
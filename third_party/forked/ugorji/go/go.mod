@@ -0,0 +1 @@
+module github.com/ugorji/go